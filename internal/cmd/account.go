@@ -102,6 +102,7 @@ func init() {
 	oauth2ClientEditCmd.Flags().StringVar(&account.Oauth2ClientSpec.Description, "description", "", "Description of the OAuth2 client")
 	oauth2ClientEditCmd.Flags().StringVar(&account.Oauth2ClientSpec.Name, "name", "", "Name of the OAuth2 client")
 	addInteractiveEditorFlag(oauth2ClientEditCmd)
+	addEditFlags(oauth2ClientEditCmd)
 	oauth2ClientCmd.AddCommand(oauth2ClientEditCmd)
 
 	rootCmd.AddCommand(accountCmd)
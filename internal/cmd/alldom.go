@@ -6,6 +6,7 @@ package cmd
 
 import (
 	"github.com/ovh/ovhcloud-cli/internal/services/alldom"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
 )
 
@@ -32,5 +33,7 @@ func init() {
 		Run:   alldom.GetAllDom,
 	})
 
+	addArgumentCompletion(alldomCmd, "<service_name>", common.CompleteFromEndpoint("/v1/allDom", "", false))
+
 	rootCmd.AddCommand(alldomCmd)
 }
@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/ovh/ovhcloud-cli/internal/services/api"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	apiCmd := &cobra.Command{
+		Use:   "api <GET|POST|PUT|DELETE> <path>",
+		Short: "Make a raw call to any endpoint of the OVHcloud API",
+		Long: `Use this command to call any endpoint of the OVHcloud API, even the ones not yet
+covered by the CLI. Requests are signed using your configured credentials.
+
+The path can target the v1 or v2 API, and may contain a query string:
+
+	ovhcloud api GET /v1/me
+	ovhcloud api GET /v2/iam/resource
+	ovhcloud api GET '/v1/cloud/project/<project_id>/instance?region=GRA9'
+
+When the API returns an array, all the pages are fetched and the results can be
+filtered using --filter. Arrays of scalar values (e.g. lists of IDs) are displayed as
+objects having a single "value" field:
+
+	ovhcloud api GET /v1/vps --filter 'value=~"^vps-"' -o json
+
+The body of POST and PUT requests can be given in a file, through a pipe or using
+your default text editor:
+
+	ovhcloud api POST /v1/domain/zone/example.com/record --from-file ./record.json
+	cat ./record.json | ovhcloud api POST /v1/domain/zone/example.com/record
+	ovhcloud api PUT /v1/vps/<service_name> --editor
+`,
+		Run:  api.CallAPI,
+		Args: cobra.ExactArgs(2),
+	}
+
+	addFromFileFlag(apiCmd)
+	addInteractiveEditorFlag(apiCmd)
+	apiCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
+
+	rootCmd.AddCommand(withFilterFlag(apiCmd))
+}
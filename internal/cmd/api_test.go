@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/maxatome/tdhttpmock"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+)
+
+func (ms *MockSuite) TestApiGetPaginatedCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("X-Pagination-Cursor") == "" {
+				resp := httpmock.NewStringResponse(200, `["vps-12345", "other-service"]`)
+				resp.Header.Set("X-Pagination-Cursor-Next", "next-page")
+				return resp, nil
+			}
+			return httpmock.NewStringResponse(200, `["vps-67890"]`), nil
+		},
+	)
+
+	out, err := cmd.Execute("api", "GET", "/v1/vps", "--filter", `value=~"^vps-"`, "-o", "json")
+
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`[{"value": "vps-12345"}, {"value": "vps-67890"}]`))
+}
+
+func (ms *MockSuite) TestApiGetObjectCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me",
+		httpmock.NewStringResponder(200, `{"nichandle": "xx1234-ovh", "country": "FR"}`).Once())
+
+	out, err := cmd.Execute("api", "get", "/v1/me", "-o", "nichandle")
+
+	require.CmpNoError(err)
+	assert.String(out, `"xx1234-ovh"`)
+}
+
+func (ms *MockSuite) TestApiGetTableCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/me/sshKey",
+		httpmock.NewStringResponder(200, `[{"keyName": "my-key", "default": false, "nested": {"field": 1}}]`).Once())
+
+	out, err := cmd.Execute("api", "GET", "/v1/me/sshKey")
+
+	require.CmpNoError(err)
+	assert.String(out, `
+┌─────────┬─────────┐
+│ default │ keyName │
+├─────────┼─────────┤
+│ false   │ my-key  │
+└─────────┴─────────┘
+💡 Use option -o json or -o yaml to get the raw output with all information`[1:])
+}
+
+func (ms *MockSuite) TestApiPostFromFileCmd(assert, require *td.T) {
+	paramsFile := filepath.Join(assert.TempDir(), "params.json")
+	require.CmpNoError(os.WriteFile(paramsFile, []byte(`{"fieldType": "A", "subDomain": "www", "target": "127.0.0.1"}`), 0600))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost, "https://eu.api.ovh.com/v1/domain/zone/example.com/record",
+		tdhttpmock.JSONBody(td.JSON(`{"fieldType": "A", "subDomain": "www", "target": "127.0.0.1"}`)),
+		httpmock.NewStringResponder(200, `{"id": 1, "fieldType": "A", "subDomain": "www", "target": "127.0.0.1"}`),
+	)
+
+	out, err := cmd.Execute("api", "POST", "/v1/domain/zone/example.com/record", "--from-file", paramsFile, "-o", "json")
+
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{"id": 1, "fieldType": "A", "subDomain": "www", "target": "127.0.0.1"}`))
+}
+
+func (ms *MockSuite) TestApiDeleteCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodDelete, "https://eu.api.ovh.com/v1/domain/zone/example.com/record/1",
+		httpmock.NewStringResponder(200, ``).Once())
+
+	out, err := cmd.Execute("api", "DELETE", "/v1/domain/zone/example.com/record/1")
+
+	require.CmpNoError(err)
+	assert.String(out, `✅ DELETE /v1/domain/zone/example.com/record/1 done`)
+}
@@ -6,8 +6,8 @@ package cmd
 
 import (
 	"github.com/ovh/ovhcloud-cli/internal/assets"
-	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/baremetal"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
 )
 
@@ -34,6 +34,23 @@ func init() {
 		Run:   baremetal.GetBaremetal,
 	})
 
+	// Command to wait for a Baremetal to match a condition
+	baremetalWaitCmd := &cobra.Command{
+		Use:   "wait <service_name>",
+		Short: "Wait for the given baremetal to match a condition",
+		Long: `Use this command to wait until the given baremetal matches a condition, expressed as a gval expression:
+
+	ovhcloud baremetal wait <service_name> --for 'powerState=="poweron"'
+	ovhcloud baremetal wait <service_name> --for 'bootId==1' --timeout 2h
+
+The command exits in error when the timeout is reached or when the failure condition matches.
+`,
+		Args: cobra.ExactArgs(1),
+		Run:  baremetal.WaitBaremetal,
+	}
+	addWaitConditionFlags(baremetalWaitCmd, baremetal.BaremetalWaitFailureCondition)
+	baremetalCmd.AddCommand(baremetalWaitCmd)
+
 	// Command to edit a single Baremetal
 	editBaremetalCmd := &cobra.Command{
 		Use:   "edit <service_name>",
@@ -51,6 +68,7 @@ func init() {
 	editBaremetalCmd.Flags().StringVar(&baremetal.EditBaremetalParams.RootDevice, "root-device", "", "Root device")
 	editBaremetalCmd.Flags().StringVar(&baremetal.EditBaremetalParams.State, "state", "", "State (e.g., error)")
 	addInteractiveEditorFlag(editBaremetalCmd)
+	addEditFlags(editBaremetalCmd)
 	baremetalCmd.AddCommand(editBaremetalCmd)
 
 	// Command to list baremetal tasks
@@ -69,6 +87,7 @@ func init() {
 		Args:  cobra.ExactArgs(1),
 		Run:   baremetal.RebootBaremetal,
 	}
+	addWaitFlags(baremetalRebootCmd, "Wait for reboot to be done before exiting")
 	baremetalCmd.AddCommand(baremetalRebootCmd)
 
 	// Command to reboot a baremetal in rescue mode
@@ -78,7 +97,7 @@ func init() {
 		Args:  cobra.ExactArgs(1),
 		Run:   baremetal.RebootRescueBaremetal,
 	}
-	baremetalRebootRescueCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for reboot to be done before exiting")
+	addWaitFlags(baremetalRebootRescueCmd, "Wait for reboot to be done before exiting")
 	baremetalCmd.AddCommand(baremetalRebootRescueCmd)
 
 	// Command to reinstall a baremetal
@@ -148,7 +167,7 @@ Please note that all parameters are not compatible with all OSes.
 	reinstallBaremetalCmd.Flags().StringVar(&baremetal.Customizations.PostInstallationScript, "post-installation-script", "", "Post-installation script")
 	reinstallBaremetalCmd.Flags().StringVar(&baremetal.Customizations.PostInstallationScriptExtension, "post-installation-script-extension", "", "Post-installation script extension (cmd, ps1)")
 	reinstallBaremetalCmd.Flags().StringVar(&baremetal.Customizations.SshKey, "ssh-key", "", "SSH public key")
-	reinstallBaremetalCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for reinstall to be done before exiting")
+	addWaitFlags(reinstallBaremetalCmd, "Wait for reinstall to be done before exiting")
 	reinstallBaremetalCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
 	baremetalCmd.AddCommand(reinstallBaremetalCmd)
 
@@ -269,5 +288,7 @@ Please note that all parameters are not compatible with all OSes.
 	baremetalIPMIGetAccessCmd.Flags().StringVar(&baremetal.BaremetalIpmiSshKey, "ssh-key", "", "Public SSH key for Serial Over Lan SSH access")
 	baremetalIPMICmd.AddCommand(baremetalIPMIGetAccessCmd)
 
+	addArgumentCompletion(baremetalCmd, "<service_name>", common.CompleteFromEndpoint("/v1/dedicated/server", "", false))
+
 	rootCmd.AddCommand(baremetalCmd)
 }
@@ -5,6 +5,8 @@
 package cmd_test
 
 import (
+	"encoding/json"
+
 	"github.com/jarcoal/httpmock"
 	"github.com/maxatome/go-testdeep/td"
 	"github.com/ovh/ovhcloud-cli/internal/cmd"
@@ -44,3 +46,16 @@ func (ms *MockSuite) TestBaremetalListCompatibleOSCmd(assert, require *td.T) {
 └────────┴────────────────────────┘
 💡 Use option -o json or -o yaml to get the raw output with all information`[1:])
 }
+
+func (ms *MockSuite) TestBaremetalRebootCmdWait(assert, require *td.T) {
+	httpmock.RegisterResponder("POST", "https://eu.api.ovh.com/v1/dedicated/server/fakeBaremetal/reboot",
+		httpmock.NewStringResponder(200, `{"taskId": 12345, "function": "hardReboot", "status": "init"}`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/dedicated/server/fakeBaremetal/task/12345",
+		httpmock.NewStringResponder(200, `{"taskId": 12345, "function": "hardReboot", "status": "doing"}`).Once().Then(
+			httpmock.NewStringResponder(200, `{"taskId": 12345, "function": "hardReboot", "status": "done"}`)))
+
+	out, err := cmd.Execute("baremetal", "reboot", "fakeBaremetal", "--wait", "--poll-interval", "10ms", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{"message": "✅ Server fakeBaremetal rebooted"}`))
+}
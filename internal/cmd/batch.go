@@ -0,0 +1,379 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+
+	shellwords "github.com/mattn/go-shellwords"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	"github.com/ovh/ovhcloud-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var (
+	batchContinueOnError bool
+
+	// batchAssignmentRegexp matches the lines assigning a variable, either with a
+	// value (name=value) or with the output of a command (name=$(command))
+	batchAssignmentRegexp = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)=(.*)$`)
+)
+
+// batchStep is a command of a batch script
+type batchStep struct {
+	line     int
+	command  string
+	variable string
+}
+
+func init() {
+	batchCmd := &cobra.Command{
+		Use:   "batch",
+		Short: "Run several commands in a single execution of the CLI",
+	}
+
+	batchRunCmd := &cobra.Command{
+		Use:   "run <file>",
+		Short: "Run the commands of a script file",
+		Long: `Run the CLI commands of the given file (use "-" to read the standard input), one command per line,
+without the "ovhcloud" prefix. The API client is initialized once and reused by all the commands.
+
+Lines are parsed like shell commands. Empty lines and lines starting with "#" are ignored, and a
+line ending with "\" continues on the next line.
+
+Variables can be defined and used in the next commands as $name or ${name}:
+
+	name=value                  Define a variable
+	name=$(<command>)           Define a variable with the output of a command, use --output
+	                            to select the value to keep (e.g. "-o id")
+
+Variables not defined in the script are read from the environment, using an undefined variable is an error.
+
+By default, the execution stops at the first failing command and the remaining commands are skipped.
+Use --continue-on-error to run all the commands.
+
+Once all the commands are run, a summary of the status of every command is displayed in JSON
+(use --output to select another format). The exit code is 1 if a command failed.`,
+		Example: `# Content of network.batch
+net_id=$(cloud network private create GRA9 --name my-network -o id)
+cloud network private subnet create $net_id --network 10.0.0.0/24 --start 10.0.0.10 --end 10.0.0.200 --region GRA9
+
+ovhcloud batch run network.batch`,
+		Args: cobra.ExactArgs(1),
+		Run:  runBatch,
+	}
+	batchRunCmd.Flags().BoolVar(&batchContinueOnError, "continue-on-error", false, "Run the next commands when a command fails instead of skipping them")
+
+	// The client is checked by each of the commands run in the batch
+	batchCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {}
+
+	batchCmd.AddCommand(batchRunCmd)
+	rootCmd.AddCommand(batchCmd)
+}
+
+func runBatch(cmd *cobra.Command, args []string) {
+	// The flags are reset after each command of the batch, keep their values
+	var (
+		continueOnError = batchContinueOnError
+		outputFormat    = flags.OutputFormatConfig
+		globalFlags     = getNestedFlags()
+		ctx             = cmd.Context()
+	)
+
+	input := os.Stdin
+	if args[0] != "-" {
+		file, err := os.Open(args[0])
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to open batch file: %s", err)
+			return
+		}
+		defer file.Close()
+		input = file
+	} else {
+		// Commands are read from the standard input, it cannot be used to give parameters
+		utils.StdinReserved = true
+		defer func() {
+			utils.StdinReserved = false
+		}()
+	}
+
+	steps, err := readBatchSteps(input)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to read batch file: %s", err)
+		return
+	}
+
+	var (
+		variables = make(map[string]string)
+		results   = make([]any, 0, len(steps))
+		failed    bool
+		stopped   bool
+	)
+
+	for _, step := range steps {
+		result := map[string]any{
+			"line":    step.line,
+			"command": step.command,
+		}
+		if step.variable != "" {
+			result["variable"] = step.variable
+		}
+		results = append(results, result)
+
+		if stopped || (failed && !continueOnError) || (ctx != nil && ctx.Err() != nil) {
+			result["status"] = "skipped"
+			continue
+		}
+
+		value, code, err := runBatchStep(step, variables, globalFlags)
+		result["exitCode"] = code
+		if err != nil {
+			failed = true
+			result["status"] = "failed"
+			result["error"] = err.Error()
+
+			// Don't run the next commands when the batch is interrupted
+			if code == display.InterruptedExitCode {
+				stopped = true
+			}
+			continue
+		}
+
+		result["status"] = "success"
+		if step.variable != "" {
+			variables[step.variable] = value
+			result["value"] = value
+		}
+	}
+
+	status := "success"
+	if failed {
+		status = "failed"
+	}
+
+	display.OutputObject(map[string]any{
+		"status": status,
+		"steps":  results,
+	}, "", "", &outputFormat)
+
+	if failed {
+		display.Exit(1)
+	}
+}
+
+// runBatchStep runs the command of the given step with the global flags of the
+// batch, and returns its output when it defines a variable
+func runBatchStep(step batchStep, variables map[string]string, globalFlags nestedFlags) (string, int, error) {
+	line, err := expandBatchVariables(step.command, variables)
+	if err != nil {
+		return "", 1, err
+	}
+
+	args, err := shellwords.Parse(line)
+	if err != nil {
+		return "", 1, fmt.Errorf("failed to parse command: %w", err)
+	}
+
+	// Allow to use commands including the name of the CLI
+	if len(args) > 0 && args[0] == rootCmd.Name() {
+		args = args[1:]
+	}
+
+	switch {
+	case len(args) == 0:
+		return "", 1, errors.New("no command given")
+	case len(args) == 1 && batchAssignmentRegexp.MatchString(args[0]):
+		// Not a command, the variable is defined with the given value
+		_, value, _ := strings.Cut(args[0], "=")
+		return value, 0, nil
+	case args[0] == "batch" || args[0] == "shell":
+		return "", 1, fmt.Errorf("command %q cannot be run in a batch", args[0])
+	}
+
+	// The output of the commands defining a variable is only kept in the variable
+	if step.variable != "" {
+		stdout := os.Stdout
+		if devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0); err == nil {
+			os.Stdout = devNull
+			defer func() {
+				os.Stdout = stdout
+				devNull.Close()
+			}()
+		}
+	}
+
+	globalFlags.apply()
+	out, code, err := ExecuteNoExit(args...)
+	PostExecute()
+
+	if err == nil && code != 0 {
+		err = fmt.Errorf("command exited with code %d", code)
+	}
+
+	// Separate the output of the command from the next ones
+	if step.variable == "" && out != "" && !strings.HasSuffix(out, "\n") {
+		fmt.Println()
+	}
+
+	return batchVariableValue(out), code, err
+}
+
+// batchVariableValue returns the value of a variable defined with the given output
+// of a command. The values extracted using an --output expression are JSON encoded,
+// strings are decoded to be usable as arguments of the next commands.
+func batchVariableValue(out string) string {
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	for i, line := range lines {
+		var value string
+		if err := json.Unmarshal([]byte(line), &value); err == nil {
+			lines[i] = value
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// readBatchSteps reads the commands of a batch script
+func readBatchSteps(input io.Reader) ([]batchStep, error) {
+	var (
+		steps   []batchStep
+		scanner = bufio.NewScanner(input)
+		current strings.Builder
+		start   int
+	)
+
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		if current.Len() == 0 {
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			start = lineNumber
+		}
+
+		// Lines ending with a backslash continue on the next line
+		if strings.HasSuffix(line, `\`) {
+			current.WriteString(strings.TrimSuffix(line, `\`) + " ")
+			continue
+		}
+		current.WriteString(line)
+
+		steps = append(steps, newBatchStep(start, strings.TrimSpace(current.String())))
+		current.Reset()
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if current.Len() > 0 {
+		steps = append(steps, newBatchStep(start, strings.TrimSpace(current.String())))
+	}
+
+	return steps, nil
+}
+
+func newBatchStep(line int, command string) batchStep {
+	step := batchStep{
+		line:    line,
+		command: command,
+	}
+
+	matches := batchAssignmentRegexp.FindStringSubmatch(command)
+	if matches == nil {
+		return step
+	}
+	step.variable = matches[1]
+
+	// Commands whose output defines a variable
+	if strings.HasPrefix(matches[2], "$(") && strings.HasSuffix(matches[2], ")") {
+		step.command = strings.TrimSpace(matches[2][2 : len(matches[2])-1])
+	}
+
+	return step
+}
+
+// expandBatchVariables replaces the variables ($name or ${name}) of the given line by
+// their value, quoted to be kept as a single word. Variables are not replaced in
+// single-quoted strings, like in a shell.
+func expandBatchVariables(line string, variables map[string]string) (string, error) {
+	var (
+		out            strings.Builder
+		inSingleQuotes bool
+		inDoubleQuotes bool
+	)
+
+	for i := 0; i < len(line); i++ {
+		char := line[i]
+
+		switch {
+		case char == '\\' && !inSingleQuotes && i+1 < len(line):
+			out.WriteByte(char)
+			out.WriteByte(line[i+1])
+			i++
+			continue
+		case char == '\'' && !inDoubleQuotes:
+			inSingleQuotes = !inSingleQuotes
+		case char == '"' && !inSingleQuotes:
+			inDoubleQuotes = !inDoubleQuotes
+		case char == '$' && !inSingleQuotes:
+			name, length := batchVariableName(line[i+1:])
+			if name == "" {
+				break
+			}
+
+			value, ok := variables[name]
+			if !ok {
+				value, ok = os.LookupEnv(name)
+			}
+			if !ok {
+				return "", fmt.Errorf("undefined variable %q", name)
+			}
+
+			if inDoubleQuotes {
+				out.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`, "$", `\$`, "`", "\\`").Replace(value))
+			} else {
+				out.WriteString("'" + strings.ReplaceAll(value, "'", `'\''`) + "'")
+			}
+			i += length
+			continue
+		}
+
+		out.WriteByte(char)
+	}
+
+	return out.String(), nil
+}
+
+// batchVariableName returns the name of the variable at the beginning of the
+// given string (name or {name}), and the length of its reference
+func batchVariableName(s string) (string, int) {
+	if strings.HasPrefix(s, "{") {
+		end := strings.IndexByte(s, '}')
+		if end < 0 {
+			return "", 0
+		}
+		return s[1:end], end + 1
+	}
+
+	end := strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	if end < 0 {
+		end = len(s)
+	}
+
+	return s[:end], end
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+	httplib "github.com/ovh/ovhcloud-cli/internal/http"
+)
+
+func (ms *MockSuite) TestBatchRunCmd(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-12345","vps-67890"]`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-12345",
+		httpmock.NewStringResponder(200, `{"name": "vps-12345", "state": "running"}`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-67890",
+		httpmock.NewStringResponder(200, `{"name": "vps-67890", "state": "stopped"}`).Times(2))
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-67890/datacenter",
+		httpmock.NewStringResponder(200, `{"name": "gra", "longName": "Gravelines"}`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-unknown",
+		httpmock.NewStringResponder(404, `{"message": "The requested object (serviceName = vps-unknown) does not exist"}`).Once())
+
+	script := filepath.Join(assert.TempDir(), "script.batch")
+	require.CmpNoError(os.WriteFile(script, []byte(`# Get the stopped VPS
+stopped=$(vps list --filter 'state=="stopped"' -o name)
+ovhcloud vps get "$stopped" \
+	-o json
+vps get vps-unknown
+vps get $undefined
+`), 0o600))
+
+	// Fail fast
+	out, code, err := cmd.ExecuteNoExit("batch", "run", script)
+	require.CmpNoError(err)
+	assert.Cmp(code, 1)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"status": "failed",
+		"steps": [
+			{
+				"line": 2,
+				"command": "vps list --filter 'state==\"stopped\"' -o name",
+				"variable": "stopped",
+				"value": "vps-67890",
+				"exitCode": 0,
+				"status": "success"
+			},
+			{
+				"line": 3,
+				"command": "ovhcloud vps get \"$stopped\"  -o json",
+				"exitCode": 0,
+				"status": "success"
+			},
+			{
+				"line": 5,
+				"command": "vps get vps-unknown",
+				"exitCode": 1,
+				"status": "failed",
+				"error": Contains("does not exist")
+			},
+			{
+				"line": 6,
+				"command": "vps get $undefined",
+				"status": "skipped"
+			}
+		]
+	}`))
+	cmd.PostExecute()
+
+	// Continue on error
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-unknown",
+		httpmock.NewStringResponder(404, `{"message": "The requested object (serviceName = vps-unknown) does not exist"}`).Once())
+
+	require.CmpNoError(os.WriteFile(script, []byte("vps get vps-unknown\nvps get $undefined\n"), 0o600))
+
+	out, code, err = cmd.ExecuteNoExit("batch", "run", script, "--continue-on-error", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(code, 1)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"status": "failed",
+		"steps": [
+			{
+				"line": 1,
+				"command": "vps get vps-unknown",
+				"exitCode": 1,
+				"status": "failed",
+				"error": Contains("does not exist")
+			},
+			{
+				"line": 2,
+				"command": "vps get $undefined",
+				"exitCode": 1,
+				"status": "failed",
+				"error": "undefined variable \"undefined\""
+			}
+		]
+	}`))
+}
+
+func (ms *MockSuite) TestBatchRunCmdDryRun(assert, require *td.T) {
+	// Intercept the calls modifying resources like the client of the CLI does
+	client := httplib.Client.Client
+	httplib.Client.Client = &http.Client{Transport: httplib.NewTransport("OVH", http.DefaultTransport)}
+	defer func() {
+		httplib.Client.Client = client
+	}()
+
+	httpmock.RegisterResponder(http.MethodPost, "https://eu.api.ovh.com/v1/vps/vps-12345/stop",
+		httpmock.NewStringResponder(200, `{}`))
+
+	httpmock.RegisterResponder(http.MethodPost, "https://eu.api.ovh.com/v1/vps/vps-12345/start",
+		httpmock.NewStringResponder(200, `{}`))
+
+	script := filepath.Join(assert.TempDir(), "script.batch")
+	require.CmpNoError(os.WriteFile(script, []byte("vps stop vps-12345\nvps start vps-12345\n"), 0o600))
+
+	// The global flags apply to all the commands of the batch
+	out, err := cmd.Execute("batch", "run", script, "--dry-run", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"status": "success",
+		"steps": [
+			{"line": 1, "command": "vps stop vps-12345", "exitCode": 0, "status": "success"},
+			{"line": 2, "command": "vps start vps-12345", "exitCode": 0, "status": "success"}
+		]
+	}`))
+	assert.Cmp(httpmock.GetCallCountInfo(), td.SuperMapOf(map[string]int{
+		"POST https://eu.api.ovh.com/v1/vps/vps-12345/stop":  0,
+		"POST https://eu.api.ovh.com/v1/vps/vps-12345/start": 0,
+	}, nil))
+}
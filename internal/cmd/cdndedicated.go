@@ -6,6 +6,7 @@ package cmd
 
 import (
 	"github.com/ovh/ovhcloud-cli/internal/services/cdndedicated"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
 )
 
@@ -32,5 +33,7 @@ func init() {
 		Run:   cdndedicated.GetCdnDedicated,
 	})
 
+	addArgumentCompletion(cdndedicatedCmd, "<service_name>", common.CompleteFromEndpoint("/v1/cdn/dedicated", "", false))
+
 	rootCmd.AddCommand(cdndedicatedCmd)
 }
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
+	"github.com/spf13/cobra"
+)
+
+const cloudManifestHelp = `The manifest is a YAML file describing the resources of the cloud project using the parameters
+of their creation (see the --init-file examples of the create commands), in the following lists:
+
+	region: GRA9                   # Default region of the resources
+	sshKeys:                       # Identified by name, cannot be updated
+	  - name: my-key
+	    publicKey: ssh-ed25519 AAAA...
+	networks:                      # Identified by name and region, cannot be updated
+	  - name: backend
+	    subnet:
+	      cidr: 10.0.0.0/24
+	      enableDhcp: true
+	subnets:                       # Identified by private network name and CIDR, cannot be updated
+	  - privateNetwork: backend
+	    network: 10.0.1.0/24
+	    start: 10.0.1.10
+	    end: 10.0.1.200
+	    dhcp: true
+	    noGateway: false
+	gateways:                      # Identified by name and region
+	  - name: backend-gateway
+	    model: s
+	    privateNetwork: backend    # Optional, attach the gateway to the given subnet
+	    subnet: 10.0.0.0/24
+	volumes:                       # Identified by name and region
+	  - name: data
+	    size: 100
+	    type: classic
+	s3Containers:                  # Identified by name and region
+	  - name: my-bucket
+	kubeClusters:                  # Identified by name
+	  - name: my-cluster
+	    privateNetwork: backend    # Optional, with the CIDR of nodesSubnet and loadBalancersSubnet
+	    nodesSubnet: 10.0.0.0/24
+	    nodepools:                 # Identified by name, not managed if not given
+	      - name: default
+	        flavorName: b3-8
+	        desiredNodes: 3
+
+The lists not given in the manifest are not managed: their resources are left untouched, even with --prune.`
+
+func initCloudApplyCommand(cloudCmd *cobra.Command) {
+	planCmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the changes needed to match the resources described in a manifest",
+		Long: `Compare the resources described in the given manifest with the resources of the cloud project,
+and show the resources that would be created, updated or deleted by the apply command.
+
+` + cloudManifestHelp,
+		Example: `ovhcloud cloud plan -f stack.yaml
+ovhcloud cloud plan -f stack.yaml --prune -o json`,
+		Args: cobra.NoArgs,
+		Run:  cloud.PlanCloudManifest,
+	}
+	addCloudManifestFlags(planCmd)
+	cloudCmd.AddCommand(planCmd)
+
+	applyCmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create, update or delete resources to match the resources described in a manifest",
+		Long: `Compare the resources described in the given manifest with the resources of the cloud project,
+show the changes and apply them once confirmed. The --yes flag is required to apply the changes
+when the command is not run in an interactive terminal (e.g. in scripts or CI pipelines).
+
+The resources are deleted first, then created or updated in dependency order (SSH keys, private
+networks, subnets, gateways, volumes, S3 containers and Kubernetes clusters), waiting for the cloud
+operations to complete so that the next resources can reference the created ones.
+
+` + cloudManifestHelp,
+		Example: `ovhcloud cloud apply -f stack.yaml
+ovhcloud cloud apply -f stack.yaml --prune --yes`,
+		Args: cobra.NoArgs,
+		Run:  cloud.ApplyCloudManifest,
+	}
+	addCloudManifestFlags(applyCmd)
+	applyCmd.Flags().BoolVarP(&flags.AssumeYes, "yes", "y", false, "Apply the changes without asking for confirmation")
+	applyCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for the created Kubernetes clusters to be ready")
+	cloudCmd.AddCommand(applyCmd)
+}
+
+// addCloudManifestFlags adds the flags of the commands using a manifest
+func addCloudManifestFlags(c *cobra.Command) {
+	c.Flags().StringVar(&cloud.CloudProject, "cloud-project", "", "Cloud project ID")
+	c.Flags().StringVarP(&cloud.CloudManifestFile, "file", "f", "", `Path of the manifest ("-" to read the standard input)`)
+	c.Flags().BoolVar(&cloud.CloudApplyPrune, "prune", false, "Delete the resources of the managed lists not described in the manifest")
+	c.MarkFlagRequired("file") //nolint:errcheck
+}
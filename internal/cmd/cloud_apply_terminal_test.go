@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build linux
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+	"golang.org/x/sys/unix"
+)
+
+// useTerminal attaches the standard input and output to a new pseudo-terminal,
+// in which the given input is typed
+func useTerminal(t *td.T, input string) {
+	ptmx, err := os.OpenFile("/dev/ptmx", os.O_RDWR, 0)
+	if err != nil {
+		t.Skipf("no pseudo-terminal available: %s", err)
+	}
+	t.Cleanup(func() { ptmx.Close() })
+
+	if err := unix.IoctlSetPointerInt(int(ptmx.Fd()), unix.TIOCSPTLCK, 0); err != nil {
+		t.Fatalf("failed to unlock pseudo-terminal: %s", err)
+	}
+	number, err := unix.IoctlGetInt(int(ptmx.Fd()), unix.TIOCGPTN)
+	if err != nil {
+		t.Fatalf("failed to get pseudo-terminal number: %s", err)
+	}
+
+	terminal, err := os.OpenFile(fmt.Sprintf("/dev/pts/%d", number), os.O_RDWR|unix.O_NOCTTY, 0)
+	if err != nil {
+		t.Fatalf("failed to open pseudo-terminal: %s", err)
+	}
+	t.Cleanup(func() { terminal.Close() })
+
+	_, err = ptmx.WriteString(input)
+	t.Require().CmpNoError(err)
+
+	previousStdin, previousStdout := os.Stdin, os.Stdout
+	os.Stdin, os.Stdout = terminal, terminal
+	t.Cleanup(func() {
+		os.Stdin, os.Stdout = previousStdin, previousStdout
+	})
+}
+
+func (ms *MockSuite) TestCloudApplyCmdCancelled(assert, require *td.T) {
+	manifest := filepath.Join(assert.TempDir(), "stack.yaml")
+	require.CmpNoError(os.WriteFile(manifest, []byte(`sshKeys:
+  - name: existing-key
+    publicKey: ssh-ed25519 AAAA
+`), 0o600))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/sshkey",
+		httpmock.NewStringResponder(200, `[
+			{"id": "key-1", "name": "existing-key", "publicKey": "ssh-ed25519 AAAA", "regions": []},
+			{"id": "key-2", "name": "old-key", "publicKey": "ssh-ed25519 BBBB", "regions": []}
+		]`))
+
+	httpmock.RegisterResponder(http.MethodDelete, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/sshkey/key-2",
+		httpmock.NewStringResponder(200, `null`))
+
+	// The changes are refused at the confirmation prompt
+	useTerminal(require, "n\n")
+
+	out, err := cmd.Execute("cloud", "apply", "-f", manifest, "--prune", "--cloud-project", "fakeProjectID", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{"message": "🟠 Apply cancelled, no resource changed"}`))
+	assert.Cmp(httpmock.GetCallCountInfo(), td.SuperMapOf(map[string]int{
+		"DELETE https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/sshkey/key-2": 0,
+	}, nil))
+}
@@ -0,0 +1,518 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/maxatome/tdhttpmock"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+)
+
+func (ms *MockSuite) TestCloudApplyCmd(assert, require *td.T) {
+	manifest := filepath.Join(assert.TempDir(), "stack.yaml")
+	require.CmpNoError(os.WriteFile(manifest, []byte(`region: GRA9
+sshKeys:
+  - name: existing-key
+    publicKey: ssh-ed25519 AAAA
+networks:
+  - name: backend
+    subnet:
+      cidr: 10.0.0.0/24
+subnets:
+  - privateNetwork: backend
+    network: 10.0.1.0/24
+    start: 10.0.1.10
+    end: 10.0.1.200
+    dhcp: true
+    noGateway: false
+`), 0o600))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/sshkey",
+		httpmock.NewStringResponder(200, `[
+			{"id": "key-1", "name": "existing-key", "publicKey": "ssh-ed25519 AAAA", "regions": []},
+			{"id": "key-2", "name": "old-key", "publicKey": "ssh-ed25519 BBBB", "regions": []}
+		]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private",
+		httpmock.NewStringResponder(200, `[]`).Once())
+
+	// Plan only
+	out, err := cmd.Execute("cloud", "plan", "-f", manifest, "--prune", "--cloud-project", "fakeProjectID", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"create": 2,
+		"update": 0,
+		"delete": 1,
+		"actions": [
+			{
+				"action": "delete",
+				"kind": "ssh key",
+				"name": "old-key"
+			},
+			{
+				"action": "create",
+				"kind": "private network",
+				"name": "backend",
+				"region": "GRA9",
+				"changes": [
+					{"kind": "added", "path": "name", "new": "backend"},
+					{"kind": "added", "path": "region", "new": "GRA9"},
+					{"kind": "added", "path": "subnet", "new": {"cidr": "10.0.0.0/24"}}
+				]
+			},
+			{
+				"action": "create",
+				"kind": "subnet",
+				"name": "10.0.1.0/24",
+				"region": "GRA9",
+				"parent": "private network \"backend\"",
+				"changes": Len(7)
+			}
+		]
+	}`))
+	cmd.PostExecute()
+
+	// Apply, the created network being fetched once the operation is completed
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private",
+		httpmock.NewStringResponder(200, `[]`).Once().Then(
+			httpmock.NewStringResponder(200, `[
+				{
+					"id": "pn-123456",
+					"name": "backend",
+					"regions": [{"region": "GRA9", "openstackId": "6d7a4a0e-9b09-11f0-993b-0050568ce122", "status": "ACTIVE"}]
+				}
+			]`).Once()))
+
+	httpmock.RegisterResponder(http.MethodDelete, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/sshkey/key-2",
+		httpmock.NewStringResponder(200, `null`).Once())
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/network",
+		tdhttpmock.JSONBody(td.JSON(`{"name": "backend", "subnet": {"cidr": "10.0.0.0/24"}}`)),
+		httpmock.NewStringResponder(200, `{"id": "operation-12345"}`).Once())
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/operation/operation-12345",
+		httpmock.NewStringResponder(200, `{
+			"id": "operation-12345",
+			"action": "network#create",
+			"resourceId": "6d7a4a0e-9b09-11f0-993b-0050568ce122",
+			"status": "completed"
+		}`))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private/pn-123456/subnet",
+		tdhttpmock.JSONBody(td.JSON(`{
+			"network": "10.0.1.0/24",
+			"start": "10.0.1.10",
+			"end": "10.0.1.200",
+			"dhcp": true,
+			"noGateway": false,
+			"region": "GRA9"
+		}`)),
+		httpmock.NewStringResponder(200, `{"id": "subnet-12345", "cidr": "10.0.1.0/24"}`).Once())
+
+	out, err = cmd.Execute("cloud", "apply", "-f", manifest, "--prune", "--cloud-project", "fakeProjectID", "--yes", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Apply complete: 2 created, 0 updated, 1 deleted",
+		"details": {
+			"create": 2,
+			"update": 0,
+			"delete": 1,
+			"actions": Len(3)
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudApplyCmdNotInteractive(assert, require *td.T) {
+	manifest := filepath.Join(assert.TempDir(), "stack.yaml")
+	require.CmpNoError(os.WriteFile(manifest, []byte(`sshKeys:
+  - name: existing-key
+    publicKey: ssh-ed25519 AAAA
+`), 0o600))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/sshkey",
+		httpmock.NewStringResponder(200, `[
+			{"id": "key-1", "name": "existing-key", "publicKey": "ssh-ed25519 AAAA", "regions": []},
+			{"id": "key-2", "name": "old-key", "publicKey": "ssh-ed25519 BBBB", "regions": []}
+		]`))
+
+	httpmock.RegisterResponder(http.MethodDelete, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/sshkey/key-2",
+		httpmock.NewStringResponder(200, `null`))
+
+	// The changes cannot be confirmed, --yes is required
+	_, code, err := cmd.ExecuteNoExit("cloud", "apply", "-f", manifest, "--prune", "--cloud-project", "fakeProjectID", "-o", "json")
+	assert.Cmp(code, 1)
+	assert.Cmp(err, td.ErrorIs(td.Contains("use --yes to apply them")))
+	assert.Cmp(httpmock.GetCallCountInfo(), td.SuperMapOf(map[string]int{
+		"DELETE https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/sshkey/key-2": 0,
+	}, nil))
+}
+
+func (ms *MockSuite) TestCloudApplyCmdUpdates(assert, require *td.T) {
+	manifest := filepath.Join(assert.TempDir(), "stack.yaml")
+	require.CmpNoError(os.WriteFile(manifest, []byte(`region: GRA9
+gateways:
+  - name: gateway-1
+    model: s
+volumes:
+  - name: data
+    size: 100
+    type: classic
+    description: Data volume
+  - name: logs
+    size: 10
+    type: classic
+s3Containers:
+  - name: backups
+    versioning:
+      status: enabled
+  - name: assets
+`), 0o600))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private",
+		httpmock.NewStringResponder(200, `[]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA9"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9",
+		httpmock.NewStringResponder(200, `{
+			"name": "GRA9",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{"name": "network", "status": "UP"},
+				{"name": "storage-s3-standard", "status": "UP"}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/gateway",
+		httpmock.NewStringResponder(200, `[{"id": "gateway-12345", "name": "gateway-1", "model": "s", "region": "GRA9", "status": "active"}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/gateway/gateway-12345",
+		httpmock.NewStringResponder(200, `{"id": "gateway-12345", "name": "gateway-1", "model": "s", "region": "GRA9", "status": "active"}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/volume",
+		httpmock.NewStringResponder(200, `[{"id": "volume-12345", "name": "data", "description": "Old volume", "size": 100, "type": "classic", "region": "GRA9", "status": "available"}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/volume/volume-12345",
+		httpmock.NewStringResponder(200, `{"id": "volume-12345", "name": "data", "description": "Old volume", "size": 100, "type": "classic", "region": "GRA9", "status": "available"}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/storage",
+		httpmock.NewStringResponder(200, `[{"name": "backups", "region": "GRA9", "objectsCount": 12, "objectsSize": 1024}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/storage/backups",
+		httpmock.NewStringResponder(200, `{
+			"name": "backups",
+			"region": "GRA9",
+			"ownerId": 1234,
+			"objectsCount": 12,
+			"objectsSize": 1024,
+			"versioning": {"status": "disabled"}
+		}`))
+
+	// The unchanged gateway has no action
+	out, err := cmd.Execute("cloud", "plan", "-f", manifest, "--cloud-project", "fakeProjectID", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"create": 2,
+		"update": 2,
+		"delete": 0,
+		"actions": [
+			{
+				"action": "update",
+				"kind": "volume",
+				"name": "data",
+				"region": "GRA9",
+				"changes": [
+					{"kind": "modified", "path": "description", "old": "Old volume", "new": "Data volume"}
+				]
+			},
+			{
+				"action": "create",
+				"kind": "volume",
+				"name": "logs",
+				"region": "GRA9",
+				"changes": Len(4)
+			},
+			{
+				"action": "update",
+				"kind": "s3 container",
+				"name": "backups",
+				"region": "GRA9",
+				"changes": [
+					{"kind": "modified", "path": "versioning.status", "old": "disabled", "new": "enabled"}
+				]
+			},
+			{
+				"action": "create",
+				"kind": "s3 container",
+				"name": "assets",
+				"region": "GRA9",
+				"changes": Len(2)
+			}
+		]
+	}`))
+	cmd.PostExecute()
+
+	httpmock.RegisterMatcherResponder(http.MethodPut,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/volume/volume-12345",
+		tdhttpmock.JSONBody(td.SuperJSONOf(`{"name": "data", "description": "Data volume"}`)),
+		httpmock.NewStringResponder(200, `null`).Once())
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/volume",
+		tdhttpmock.JSONBody(td.JSON(`{"name": "logs", "size": 10, "type": "classic"}`)),
+		httpmock.NewStringResponder(200, `{"id": "operation-12345"}`).Once())
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/operation/operation-12345",
+		httpmock.NewStringResponder(200, `{
+			"id": "operation-12345",
+			"action": "ablockstorage.CreateVolume",
+			"resourceId": "volume-67890",
+			"status": "completed"
+		}`))
+
+	httpmock.RegisterMatcherResponder(http.MethodPut,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/storage/backups",
+		tdhttpmock.JSONBody(td.SuperJSONOf(`{"versioning": {"status": "enabled"}}`)),
+		httpmock.NewStringResponder(200, `null`).Once())
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/storage",
+		tdhttpmock.JSONBody(td.JSON(`{"name": "assets"}`)),
+		httpmock.NewStringResponder(200, `{"name": "assets", "region": "GRA9"}`).Once())
+
+	out, err = cmd.Execute("cloud", "apply", "-f", manifest, "--cloud-project", "fakeProjectID", "--yes", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Apply complete: 2 created, 2 updated, 0 deleted",
+		"details": {
+			"create": 2,
+			"update": 2,
+			"delete": 0,
+			"actions": Len(4)
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudApplyCmdGatewayOnSubnet(assert, require *td.T) {
+	manifest := filepath.Join(assert.TempDir(), "stack.yaml")
+	require.CmpNoError(os.WriteFile(manifest, []byte(`region: GRA9
+gateways:
+  - name: gateway-1
+    model: s
+    privateNetwork: backend
+    subnet: 10.0.0.0/24
+`), 0o600))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private",
+		httpmock.NewStringResponder(200, `[{
+			"id": "pn-123456",
+			"name": "backend",
+			"regions": [{"region": "GRA9", "openstackId": "6d7a4a0e-9b09-11f0-993b-0050568ce122", "status": "ACTIVE"}]
+		}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA9"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9",
+		httpmock.NewStringResponder(200, `{"name": "GRA9", "type": "region", "status": "UP", "services": [{"name": "network", "status": "UP"}]}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/gateway",
+		httpmock.NewStringResponder(200, `[]`))
+
+	// The OpenStack ID of the subnet is resolved from its CIDR
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/network/6d7a4a0e-9b09-11f0-993b-0050568ce122/subnet",
+		httpmock.NewStringResponder(200, `[
+			{"id": "0f0b4f8a-9b0a-11f0-8de9-0242ac120002", "cidr": "10.0.1.0/24"},
+			{"id": "1c6f3c3e-9b0a-11f0-8de9-0242ac120002", "cidr": "10.0.0.0/24"}
+		]`))
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/network/6d7a4a0e-9b09-11f0-993b-0050568ce122/subnet/1c6f3c3e-9b0a-11f0-8de9-0242ac120002/gateway",
+		tdhttpmock.JSONBody(td.JSON(`{"name": "gateway-1", "model": "s"}`)),
+		httpmock.NewStringResponder(200, `{"id": "operation-12345"}`).Once())
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/operation/operation-12345",
+		httpmock.NewStringResponder(200, `{
+			"id": "operation-12345",
+			"action": "gateway#create",
+			"resourceId": "gateway-12345",
+			"status": "completed"
+		}`))
+
+	out, err := cmd.Execute("cloud", "apply", "-f", manifest, "--cloud-project", "fakeProjectID", "--yes", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Apply complete: 1 created, 0 updated, 0 deleted",
+		"details": {
+			"create": 1,
+			"update": 0,
+			"delete": 0,
+			"actions": [
+				{
+					"action": "create",
+					"kind": "gateway",
+					"name": "gateway-1",
+					"region": "GRA9",
+					"changes": Len(5)
+				}
+			]
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudApplyCmdKubeNodepools(assert, require *td.T) {
+	manifest := filepath.Join(assert.TempDir(), "stack.yaml")
+	require.CmpNoError(os.WriteFile(manifest, []byte(`region: GRA9
+kubeClusters:
+  - name: prod
+    version: "1.31"
+    nodepools:
+      - name: default
+        flavorName: b3-8
+        desiredNodes: 5
+      - name: gpu
+        flavorName: t1-45
+        desiredNodes: 1
+`), 0o600))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private",
+		httpmock.NewStringResponder(200, `[]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube",
+		httpmock.NewStringResponder(200, `["kube-1"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1",
+		httpmock.NewStringResponder(200, `{
+			"id": "kube-1",
+			"name": "prod",
+			"region": "GRA9",
+			"version": "1.31",
+			"status": "READY"
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1/nodepool",
+		httpmock.NewStringResponder(200, `[
+			{"id": "nodepool-1", "name": "default", "flavor": "b3-8", "desiredNodes": 3, "status": "READY"},
+			{"id": "nodepool-2", "name": "legacy", "flavor": "b2-7", "desiredNodes": 2, "status": "READY"}
+		]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1/nodepool/nodepool-1",
+		httpmock.NewStringResponder(200, `{"id": "nodepool-1", "name": "default", "flavor": "b3-8", "desiredNodes": 3, "status": "READY"}`))
+
+	httpmock.RegisterMatcherResponder(http.MethodPut,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1/nodepool/nodepool-1",
+		tdhttpmock.JSONBody(td.SuperJSONOf(`{"desiredNodes": 5}`)),
+		httpmock.NewStringResponder(200, `null`).Once())
+
+	httpmock.RegisterMatcherResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1/nodepool",
+		tdhttpmock.JSONBody(td.JSON(`{"name": "gpu", "flavorName": "t1-45", "desiredNodes": 1}`)),
+		httpmock.NewStringResponder(200, `{"id": "nodepool-3", "name": "gpu"}`).Once())
+
+	httpmock.RegisterResponder(http.MethodDelete, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1/nodepool/nodepool-2",
+		httpmock.NewStringResponder(200, `null`).Once())
+
+	// The cluster is up to date, only its node pools are changed
+	out, err := cmd.Execute("cloud", "apply", "-f", manifest, "--prune", "--cloud-project", "fakeProjectID", "--yes", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Apply complete: 1 created, 1 updated, 1 deleted",
+		"details": {
+			"create": 1,
+			"update": 1,
+			"delete": 1,
+			"actions": [
+				{
+					"action": "delete",
+					"kind": "kube node pool",
+					"name": "legacy",
+					"parent": "kube cluster \"prod\""
+				},
+				{
+					"action": "update",
+					"kind": "kube node pool",
+					"name": "default",
+					"parent": "kube cluster \"prod\"",
+					"changes": [
+						{"kind": "modified", "path": "desiredNodes", "old": 3, "new": 5}
+					]
+				},
+				{
+					"action": "create",
+					"kind": "kube node pool",
+					"name": "gpu",
+					"parent": "kube cluster \"prod\"",
+					"changes": Len(3)
+				}
+			]
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestCloudApplyCmdDeleteOrder(assert, require *td.T) {
+	manifest := filepath.Join(assert.TempDir(), "stack.yaml")
+	require.CmpNoError(os.WriteFile(manifest, []byte(`region: GRA9
+sshKeys: []
+networks: []
+volumes: []
+s3Containers: []
+kubeClusters: []
+`), 0o600))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/sshkey",
+		httpmock.NewStringResponder(200, `[{"id": "key-1", "name": "old-key", "publicKey": "ssh-ed25519 AAAA", "regions": []}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private",
+		httpmock.NewStringResponder(200, `[{
+			"id": "pn-123456",
+			"name": "backend",
+			"regions": [{"region": "GRA9", "openstackId": "6d7a4a0e-9b09-11f0-993b-0050568ce122", "status": "ACTIVE"}]
+		}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/volume",
+		httpmock.NewStringResponder(200, `[{"id": "volume-12345", "name": "data", "region": "GRA9"}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA9"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9",
+		httpmock.NewStringResponder(200, `{"name": "GRA9", "type": "region", "status": "UP", "services": [{"name": "storage-s3-standard", "status": "UP"}]}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA9/storage",
+		httpmock.NewStringResponder(200, `[{"name": "backups", "region": "GRA9"}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube",
+		httpmock.NewStringResponder(200, `["kube-1"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1",
+		httpmock.NewStringResponder(200, `{"id": "kube-1", "name": "prod", "region": "GRA9"}`))
+
+	// The resources are deleted before the resources they depend on
+	out, err := cmd.Execute("cloud", "plan", "-f", manifest, "--prune", "--cloud-project", "fakeProjectID", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"create": 0,
+		"update": 0,
+		"delete": 5,
+		"actions": [
+			{"action": "delete", "kind": "kube cluster", "name": "prod", "region": "GRA9"},
+			{"action": "delete", "kind": "s3 container", "name": "backups", "region": "GRA9"},
+			{"action": "delete", "kind": "volume", "name": "data", "region": "GRA9"},
+			{"action": "delete", "kind": "private network", "name": "backend"},
+			{"action": "delete", "kind": "ssh key", "name": "old-key"}
+		]
+	}`))
+}
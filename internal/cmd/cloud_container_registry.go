@@ -40,6 +40,7 @@ func initContainerRegistryCommand(cloudCmd *cobra.Command) {
 	}
 	editCmd.Flags().StringVar(&cloud.CloudContainerRegistryName, "name", "", "New name for the container registry")
 	addInteractiveEditorFlag(editCmd)
+	addEditFlags(editCmd)
 	registryCmd.AddCommand(editCmd)
 
 	createCmd := &cobra.Command{
@@ -50,6 +51,7 @@ func initContainerRegistryCommand(cloudCmd *cobra.Command) {
 	createCmd.Flags().StringVar(&cloud.CloudContainerRegistrySpec.Name, "name", "", "Name of the container registry")
 	createCmd.Flags().StringVar(&cloud.CloudContainerRegistrySpec.PlanID, "plan-id", "", "Plan ID for the container registry. Available plans can be listed with 'ovhcloud cloud reference container-registry list-plans'")
 	createCmd.Flags().StringVar(&cloud.CloudContainerRegistrySpec.Region, "region", "", "Region for the container registry (e.g., DE, GRA, BHS)")
+	createCmd.RegisterFlagCompletionFunc("region", cloud.CompleteContainerRegistryRegion)
 	addInitParameterFileFlag(createCmd, assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/containerRegistry", "post", cloud.CloudContainerRegistryCreateSample, nil)
 	addInteractiveEditorFlag(createCmd)
 	addFromFileFlag(createCmd)
@@ -301,6 +303,7 @@ func initContainerRegistryOIDCCommand(registryCmd *cobra.Command) {
 	editCmd.Flags().BoolVar(&cloud.CloudContainerRegistryOidcEditSpec.AutoOnboard, "auto-onboard", false, "Automatically create users on first login")
 	editCmd.Flags().BoolVar(&cloud.CloudContainerRegistryOidcEditSpec.VerifyCert, "verify-cert", false, "Verify the provider TLS certificate")
 	addInteractiveEditorFlag(editCmd)
+	addEditFlags(editCmd)
 	oidcCmd.AddCommand(editCmd)
 
 	deleteCmd := &cobra.Command{
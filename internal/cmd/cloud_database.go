@@ -32,6 +32,22 @@ func initCloudDatabaseCommand(cloudCmd *cobra.Command) {
 		Args:  cobra.ExactArgs(1),
 	})
 
+	databaseWaitCmd := &cobra.Command{
+		Use:   "wait <cluster_id>",
+		Short: "Wait for the given database service to match a condition",
+		Long: `Use this command to wait until the given database service matches a condition, expressed as a gval expression:
+
+	ovhcloud cloud database-service wait <cluster_id> --for 'status=="READY"'
+	ovhcloud cloud database-service wait <cluster_id> --for 'status=="READY" && plan=="business"' --timeout 30m
+
+The command exits in error when the timeout is reached or when the failure condition matches.
+`,
+		Run:  cloud.WaitCloudDatabase,
+		Args: cobra.ExactArgs(1),
+	}
+	addWaitConditionFlags(databaseWaitCmd, cloud.CloudDatabaseWaitFailureCondition)
+	databaseCmd.AddCommand(databaseWaitCmd)
+
 	databaseCmd.AddCommand(getDatabaseCreationCmd())
 	databaseCmd.AddCommand(getDatabaseEditCmd())
 
@@ -81,6 +97,8 @@ func initCloudDatabaseCommand(cloudCmd *cobra.Command) {
 		Args:  cobra.ExactArgs(2),
 	})
 
+	addArgumentCompletion(databaseCmd, "<cluster_id>", cloud.CompleteDatabaseClusterID)
+
 	cloudCmd.AddCommand(databaseCmd)
 }
 
@@ -143,6 +161,7 @@ There are two ways to define the creation parameters:
 
 	// Common flags for other mean to define parameters
 	addInteractiveEditorFlag(databaseCreateCmd)
+	addWaitFlags(databaseCreateCmd, "Wait for the database service to be ready before exiting")
 
 	return databaseCreateCmd
 }
@@ -179,6 +198,7 @@ There are two ways to define the edition parameters:
 	databaseEditCmd.Flags().StringVar(&cloud.DatabaseSpec.Description, "description", "", "Description of the cluster")
 	databaseEditCmd.Flags().BoolVar(&cloud.DatabaseSpec.EnablePrometheus, "enable-prometheus", false, "Enable Prometheus")
 	databaseEditCmd.Flags().StringVar(&cloud.DatabaseSpec.NodesPattern.Flavor, "flavor", "", "The VM flavor used for this cluster")
+	databaseEditCmd.RegisterFlagCompletionFunc("flavor", cloud.CompleteDatabaseFlavor)
 	databaseEditCmd.Flags().StringVar(&cloud.DatabaseSpec.MaintenanceTime, "maintenance-time", "", "Time on which maintenances can start every day")
 	databaseEditCmd.Flags().StringVar(&cloud.DatabaseSpec.Plan, "plan", "", "Plan of the cluster")
 	databaseEditCmd.Flags().StringVar(&cloud.DatabaseSpec.Version, "version", "", "Version of the engine deployed on the cluster")
@@ -188,6 +208,7 @@ There are two ways to define the edition parameters:
 
 	// Common flags for other mean to define parameters
 	addInteractiveEditorFlag(databaseEditCmd)
+	addEditFlags(databaseEditCmd)
 
 	return databaseEditCmd
 }
@@ -5,6 +5,7 @@
 package cmd_test
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/jarcoal/httpmock"
@@ -37,6 +38,39 @@ func (ms *MockSuite) TestCloudDatabaseCreateCmd(assert, require *td.T) {
 	assert.String(out, `✅ Database created successfully (id: 0f0c43f0-979a-11f0-94fd-0050568ce122)`)
 }
 
+func (ms *MockSuite) TestCloudDatabaseCreateCmdWithWait(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodPost,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/database/mysql",
+		httpmock.NewStringResponder(200, `{"id": "fakeDatabaseID", "status": "CREATING"}`),
+	)
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/database/service/fakeDatabaseID",
+		httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(200, `{"id": "fakeDatabaseID", "status": "CREATING"}`),
+			httpmock.NewStringResponse(200, `{"id": "fakeDatabaseID", "status": "READY"}`),
+		}),
+	)
+
+	out, err := cmd.Execute("cloud", "database-service", "create", "--cloud-project", "fakeProjectID", "--engine", "mysql",
+		"--version", "8", "--plan", "essential", "--nodes-pattern.flavor", "db1-4", "--nodes-pattern.number", "1", "--nodes-pattern.region", "DE", "--wait", "--poll-interval", "10ms", "-o", "json")
+
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ Database created successfully and ready (id: fakeDatabaseID)",
+		"details": {
+			"description": "database fakeDatabaseID to be ready",
+			"status": "READY",
+			"attempts": 2,
+			"elapsed": "0s",
+			"resource": {
+				"id": "fakeDatabaseID",
+				"status": "READY"
+			}
+		}
+	}`))
+}
+
 func (ms *MockSuite) TestCloudDatabaseEditCmd(assert, require *td.T) {
 	httpmock.RegisterResponder(http.MethodGet,
 		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/database/service/fakeDatabaseID",
@@ -8,7 +8,6 @@ import (
 	"runtime"
 
 	"github.com/ovh/ovhcloud-cli/internal/assets"
-	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
 	"github.com/spf13/cobra"
 )
@@ -62,15 +61,18 @@ There are three ways to define the creation parameters:
 
 	ovhcloud cloud instance create RBX8 --editor --image-selector --flavor-selector
 `,
-		Run:  cloud.CreateInstance,
-		Args: cobra.MaximumNArgs(1),
+		Run:               cloud.CreateInstance,
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: cloud.CompleteRegion,
 	}
 
 	// Add flags for instance creation parameters
 	instanceCreateCmd.Flags().StringVar(&cloud.InstanceCreationParameters.AvailabilityZone, "availability-zone", "", "Availability zone")
 	instanceCreateCmd.Flags().StringVar(&cloud.InstanceCreationParameters.BillingPeriod, "billing-period", "hourly", "Billing period (hourly, monthly), default is hourly")
+	instanceCreateCmd.RegisterFlagCompletionFunc("billing-period", cloud.CompleteBillingPeriod)
 	instanceCreateCmd.Flags().IntVar(&cloud.InstanceCreationParameters.Bulk, "bulk", 0, "Number of instances to create")
 	instanceCreateCmd.Flags().StringVar(&cloud.InstanceCreationParameters.Flavor.ID, "flavor", "", "Flavor ID (you can use 'ovhcloud cloud reference list-flavors' to get the flavor ID)")
+	instanceCreateCmd.RegisterFlagCompletionFunc("flavor", cloud.CompleteInstanceFlavor)
 	instanceCreateCmd.Flags().StringVar(&cloud.InstanceCreationParameters.Group.ID, "group", "", "Group ID")
 	instanceCreateCmd.Flags().StringVar(&cloud.InstanceCreationParameters.Name, "name", "", "Instance name")
 
@@ -131,7 +133,7 @@ There are three ways to define the creation parameters:
 	addInitParameterFileFlag(instanceCreateCmd, assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/instance", "post", cloud.CloudInstanceCreationExample, cloud.GetInstanceFlavorAndImageInteractiveSelector)
 	addInteractiveEditorFlag(instanceCreateCmd)
 	addFromFileFlag(instanceCreateCmd)
-	instanceCreateCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for instance creation to be done before exiting")
+	addWaitFlags(instanceCreateCmd, "Wait for instance creation to be done before exiting")
 	if !(runtime.GOARCH == "wasm" && runtime.GOOS == "js") {
 		instanceCreateCmd.Flags().BoolVar(&cloud.InstanceImageViaInteractiveSelector, "image-selector", false, "Use the interactive image selector")
 		instanceCreateCmd.Flags().BoolVar(&cloud.InstanceFlavorViaInteractiveSelector, "flavor-selector", false, "Use the interactive flavor selector")
@@ -163,6 +165,22 @@ func initInstanceCommand(cloudCmd *cobra.Command) {
 		Args:  cobra.ExactArgs(1),
 	})
 
+	instanceWaitCmd := &cobra.Command{
+		Use:   "wait <instance_id>",
+		Short: "Wait for the given instance to match a condition",
+		Long: `Use this command to wait until the given instance matches a condition, expressed as a gval expression:
+
+	ovhcloud cloud instance wait <instance_id> --for 'status=="ACTIVE"'
+	ovhcloud cloud instance wait <instance_id> --for 'status=="SHUTOFF"' --timeout 10m
+
+The command exits in error when the timeout is reached or when the failure condition matches.
+`,
+		Run:  cloud.WaitInstance,
+		Args: cobra.ExactArgs(1),
+	}
+	addWaitConditionFlags(instanceWaitCmd, cloud.InstanceWaitFailureCondition)
+	instanceCmd.AddCommand(instanceWaitCmd)
+
 	instanceCmd.AddCommand(getInstanceCreationCmd())
 
 	instanceCmd.AddCommand(&cobra.Command{
@@ -179,19 +197,23 @@ func initInstanceCommand(cloudCmd *cobra.Command) {
 		Args:  cobra.ExactArgs(2),
 	})
 
-	instanceCmd.AddCommand(&cobra.Command{
+	startCmd := &cobra.Command{
 		Use:   "start <instance_id>",
 		Short: "Start the given instance",
 		Run:   cloud.StartInstance,
 		Args:  cobra.ExactArgs(1),
-	})
+	}
+	addWaitFlags(startCmd, "Wait for the instance to be started before exiting")
+	instanceCmd.AddCommand(startCmd)
 
-	instanceCmd.AddCommand(&cobra.Command{
+	stopCmd := &cobra.Command{
 		Use:   "stop <instance_id>",
 		Short: "Stop the given instance",
 		Run:   cloud.StopInstance,
 		Args:  cobra.ExactArgs(1),
-	})
+	}
+	addWaitFlags(stopCmd, "Wait for the instance to be stopped before exiting")
+	instanceCmd.AddCommand(stopCmd)
 
 	instanceCmd.AddCommand(&cobra.Command{
 		Use:   "shelve <instance_id>",
@@ -228,6 +250,7 @@ Instance billing will get back to normal and the snapshot used to store the inst
 		Args:  cobra.ExactArgs(1),
 	}
 	rebootCmd.Flags().StringVarP(&cloud.InstanceRebootType, "type", "t", "soft", "Reboot type: hard or soft (default is soft)")
+	addWaitFlags(rebootCmd, "Wait for the instance to be rebooted before exiting")
 	instanceCmd.AddCommand(rebootCmd)
 
 	reinstallCmd := &cobra.Command{
@@ -283,7 +306,7 @@ There are three ways to define the installation parameters:
 	addInteractiveEditorFlag(reinstallCmd)
 	addFromFileFlag(reinstallCmd)
 	reinstallCmd.Flags().StringVar(&cloud.InstanceImageID, "image", "", "Image to use for reinstallation")
-	reinstallCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for reinstall to be done before exiting")
+	addWaitFlags(reinstallCmd, "Wait for reinstall to be done before exiting")
 	if !(runtime.GOARCH == "wasm" && runtime.GOOS == "js") {
 		reinstallCmd.Flags().BoolVar(&cloud.InstanceImageViaInteractiveSelector, "image-selector", false, "Use the interactive image selector to define installation parameters")
 		reinstallCmd.MarkFlagsMutuallyExclusive("from-file", "editor", "image-selector")
@@ -339,7 +362,7 @@ There are three ways to define the installation parameters:
 		Args:  cobra.ExactArgs(1),
 	}
 	enableRescueCmd.Flags().StringVar(&cloud.InstanceImageID, "image", "", "Image to boot from")
-	enableRescueCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for instance to be in rescue mode before exiting")
+	addWaitFlags(enableRescueCmd, "Wait for instance to be in rescue mode before exiting")
 	instanceCmd.AddCommand(enableRescueCmd)
 
 	disableRescueCmd := &cobra.Command{
@@ -348,7 +371,7 @@ There are three ways to define the installation parameters:
 		Run:   cloud.DisableInstanceRescueMode,
 		Args:  cobra.ExactArgs(1),
 	}
-	disableRescueCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for instance to have exited rescue mode before exiting")
+	addWaitFlags(disableRescueCmd, "Wait for instance to have exited rescue mode before exiting")
 	instanceCmd.AddCommand(disableRescueCmd)
 
 	setFlavorCmd := &cobra.Command{
@@ -357,7 +380,7 @@ There are three ways to define the installation parameters:
 		Run:   cloud.SetInstanceFlavor,
 		Args:  cobra.RangeArgs(1, 2),
 	}
-	setFlavorCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for instance to run with the desired flavor before exiting")
+	addWaitFlags(setFlavorCmd, "Wait for instance to run with the desired flavor before exiting")
 	setFlavorCmd.Flags().BoolVar(&cloud.InstanceFlavorViaInteractiveSelector, "flavor-selector", false, "Use the interactive flavor selector")
 	instanceCmd.AddCommand(setFlavorCmd)
 
@@ -407,5 +430,7 @@ There are three ways to define the installation parameters:
 		Args:  cobra.ExactArgs(1),
 	})
 
+	addArgumentCompletion(instanceCmd, "<instance_id>", cloud.CompleteInstanceID)
+
 	cloudCmd.AddCommand(instanceCmd)
 }
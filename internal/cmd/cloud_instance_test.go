@@ -124,3 +124,15 @@ func (ms *MockSuite) TestCloudInstanceNullImageCmd(assert, require *td.T) {
 
 `)
 }
+
+func (ms *MockSuite) TestCloudInstanceWaitCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/fakeInstanceID",
+		httpmock.NewStringResponder(200, `{"id": "fakeInstanceID", "status": "ACTIVE"}`),
+	)
+
+	out, err := cmd.Execute("cloud", "instance", "wait", "fakeInstanceID", "--cloud-project", "fakeProjectID", "--for", `status=="ACTIVE"`)
+
+	require.CmpNoError(err)
+	assert.String(out, `✅ Instance fakeInstanceID matches condition status=="ACTIVE"`)
+}
@@ -36,6 +36,22 @@ func initKubeCommand(cloudCmd *cobra.Command) {
 		Args:  cobra.ExactArgs(1),
 	})
 
+	kubeWaitCmd := &cobra.Command{
+		Use:   "wait <cluster_id>",
+		Short: "Wait for the given Kubernetes cluster to match a condition",
+		Long: `Use this command to wait until the given Kubernetes cluster matches a condition, expressed as a gval expression:
+
+	ovhcloud cloud kube wait <cluster_id> --for 'status=="READY"'
+	ovhcloud cloud kube wait <cluster_id> --for 'status=="READY" && version=="1.32"' --timeout 30m
+
+The command exits in error when the timeout is reached or when the failure condition matches.
+`,
+		Run:  cloud.WaitKube,
+		Args: cobra.ExactArgs(1),
+	}
+	addWaitConditionFlags(kubeWaitCmd, cloud.KubeWaitFailureCondition)
+	kubeCmd.AddCommand(kubeWaitCmd)
+
 	kubeCmd.AddCommand(getKubeCreateCmd())
 
 	kubeEditCmd := &cobra.Command{
@@ -47,6 +63,7 @@ func initKubeCommand(cloudCmd *cobra.Command) {
 	kubeEditCmd.Flags().StringVar(&cloud.KubeSpec.Name, "name", "", "Name of the Kubernetes cluster")
 	kubeEditCmd.Flags().StringVar(&cloud.KubeSpec.UpdatePolicy, "update-policy", "", "Update policy for the cluster (ALWAYS_UPDATE, MINIMAL_DOWNTIME, NEVER_UPDATE)")
 	kubeEditCmd.Flags().BoolVar(&flags.ParametersViaEditor, "editor", false, "Use a text editor to define edit parameters")
+	addEditFlags(kubeEditCmd)
 	kubeCmd.AddCommand(kubeEditCmd)
 
 	kubeCmd.AddCommand(&cobra.Command{
@@ -86,6 +103,7 @@ func initKubeCommand(cloudCmd *cobra.Command) {
 	customizationEditCmd.Flags().StringVar(&cloud.KubeSpec.Customization.KubeProxy.IPVS.TCPTimeout, "kube-proxy.ipvs.tcp-timeout", "", "Timeout value used for idle IPVS TCP sessions in RFC3339 duration format (e.g. 'PT60S')")
 	customizationEditCmd.Flags().StringVar(&cloud.KubeSpec.Customization.KubeProxy.IPVS.UDPTimeout, "kube-proxy.ipvs.udp-timeout", "", "Timeout value used for IPVS UDP packets in RFC3339 duration format (e.g. 'PT60S')")
 	addInteractiveEditorFlag(customizationEditCmd)
+	addEditFlags(customizationEditCmd)
 	customizationCmd.AddCommand(customizationEditCmd)
 
 	ipRestrictionsCmd := &cobra.Command{
@@ -221,6 +239,7 @@ func initKubeCommand(cloudCmd *cobra.Command) {
 	editCmd.Flags().StringSliceVar(&cloud.KubeOIDCConfig.SigningAlgorithms, "signing-algorithms", nil, "OIDC signing algorithm(s) (ES256, ES384, ES512, PS256, PS384, PS512, RS256, RS384, RS512)")
 	editCmd.Flags().StringVar(&cloud.KubeOIDCConfig.UsernameClaim, "username-claim", "", "OIDC username claim")
 	editCmd.Flags().StringVar(&cloud.KubeOIDCConfig.UsernamePrefix, "username-prefix", "", "Prefix prepended to username claims")
+	addEditFlags(editCmd)
 	oidcCmd.AddCommand(editCmd)
 
 	oidcCmd.AddCommand(getKubeOIDCCreateCmd())
@@ -254,6 +273,7 @@ func initKubeCommand(cloudCmd *cobra.Command) {
 	privateNetworkConfigEditCmd.Flags().StringVar(&cloud.KubeSpec.PrivateNetworkConfiguration.DefaultVrackGateway, "default-vrack-gateway", "", "If defined, all egress traffic will be routed towards this IP address, which should belong to the private network")
 	privateNetworkConfigEditCmd.Flags().BoolVar(&cloud.KubeSpec.PrivateNetworkConfiguration.PrivateNetworkRoutingAsDefault, "routing-as-default", false, "Set private network routing as default")
 	addInteractiveEditorFlag(privateNetworkConfigEditCmd)
+	addEditFlags(privateNetworkConfigEditCmd)
 	privateNetworkConfigCmd.AddCommand(privateNetworkConfigEditCmd)
 
 	kubeCmd.AddCommand(getKubeResetCmd())
@@ -275,6 +295,7 @@ func initKubeCommand(cloudCmd *cobra.Command) {
 	}
 	kubeUpdateCmd.Flags().StringVar(&cloud.KubeUpdateStrategy, "strategy", "", "Update strategy to apply on your service (LATEST_PATCH, NEXT_MINOR)")
 	kubeUpdateCmd.Flags().BoolVar(&cloud.KubeForceAction, "force", false, "Force redeploying the control plane / reinstalling the nodes regardless of their current version")
+	addWaitFlags(kubeUpdateCmd, "Wait for the update to be done before exiting")
 	kubeCmd.AddCommand(kubeUpdateCmd)
 
 	kubeCmd.AddCommand(&cobra.Command{
@@ -284,6 +305,8 @@ func initKubeCommand(cloudCmd *cobra.Command) {
 		Args:  cobra.ExactArgs(2),
 	})
 
+	addArgumentCompletion(kubeCmd, "<cluster_id>", cloud.CompleteKubeClusterID)
+
 	cloudCmd.AddCommand(kubeCmd)
 }
 
@@ -334,6 +357,7 @@ There are three ways to define the creation parameters:
 	// All flags for Kubernetes cluster creation
 	kubeCreateCmd.Flags().StringVar(&cloud.KubeSpec.Name, "name", "", "Name of the Kubernetes cluster")
 	kubeCreateCmd.Flags().StringVar(&cloud.KubeSpec.Region, "region", "", "Region for the Kubernetes cluster")
+	kubeCreateCmd.RegisterFlagCompletionFunc("region", cloud.CompleteRegion)
 	kubeCreateCmd.Flags().StringVar(&cloud.KubeSpec.Version, "version", "", "Kubernetes version")
 	kubeCreateCmd.Flags().StringVar(&cloud.KubeSpec.Plan, "plan", "", "Kubernetes cluster plan (free or standard, default: free)")
 	kubeCreateCmd.Flags().StringVar(&cloud.KubeSpec.KubeProxyMode, "kube-proxy-mode", "", "Kube-proxy mode (iptables or ipvs)")
@@ -367,6 +391,7 @@ There are three ways to define the creation parameters:
 	addInteractiveEditorFlag(kubeCreateCmd)
 	addFromFileFlag(kubeCreateCmd)
 	kubeCreateCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
+	addWaitFlags(kubeCreateCmd, "Wait for the Kubernetes cluster to be ready before exiting")
 
 	return kubeCreateCmd
 }
@@ -453,6 +478,7 @@ There are three ways to define the reset parameters:
 	addInteractiveEditorFlag(kubeResetCmd)
 	addFromFileFlag(kubeResetCmd)
 	kubeResetCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
+	addWaitFlags(kubeResetCmd, "Wait for the reset to be done before exiting")
 
 	return kubeResetCmd
 }
@@ -483,6 +509,7 @@ func getNodepoolEditCmd() *cobra.Command {
 	cloud.KubeNodepoolSpec.AttachFloatingIps.Enabled = &attachFloatingIpsEnabled
 
 	addInteractiveEditorFlag(nodepoolEditCmd)
+	addEditFlags(nodepoolEditCmd)
 
 	return nodepoolEditCmd
 }
@@ -6,7 +6,6 @@ package cmd
 
 import (
 	"github.com/ovh/ovhcloud-cli/internal/assets"
-	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
 	"github.com/spf13/cobra"
 )
@@ -49,6 +48,7 @@ func initCloudNetworkCommand(cloudCmd *cobra.Command) {
 	}
 	privateNetworkEditCmd.Flags().StringVar(&cloud.CloudNetworkName, "name", "", "Name of the private network")
 	addInteractiveEditorFlag(privateNetworkEditCmd)
+	addEditFlags(privateNetworkEditCmd)
 	privateNetworkCmd.AddCommand(privateNetworkEditCmd)
 
 	privateNetworkCmd.AddCommand(getPrivateNetworkCreationCmd())
@@ -115,6 +115,7 @@ func initCloudNetworkCommand(cloudCmd *cobra.Command) {
 	privateNetworkSubnetEditCmd.Flags().BoolVar(&cloud.CloudNetworkSubnetEditSpec.DisableGateway, "disable-gateway", false, "Set to true if you want to disable the default gateway")
 	privateNetworkSubnetEditCmd.Flags().StringVar(&cloud.CloudNetworkSubnetEditSpec.GatewayIp, "gateway-ip", "", "Gateway IP address")
 	addInteractiveEditorFlag(privateNetworkSubnetEditCmd)
+	addEditFlags(privateNetworkSubnetEditCmd)
 	privateNetworkSubnetCmd.AddCommand(privateNetworkSubnetEditCmd)
 
 	privateNetworkSubnetCmd.AddCommand(&cobra.Command{
@@ -179,6 +180,7 @@ func initCloudNetworkCommand(cloudCmd *cobra.Command) {
 	gatewayEditCmd.Flags().StringVar(&cloud.CloudGatewaySpec.Name, "name", "", "Name of the gateway")
 	gatewayEditCmd.Flags().StringVar(&cloud.CloudGatewaySpec.Model, "model", "", "Model of the gateway (s, m, l, xl, 2xl, 3xl)")
 	addInteractiveEditorFlag(gatewayEditCmd)
+	addEditFlags(gatewayEditCmd)
 	gatewayCmd.AddCommand(gatewayEditCmd)
 
 	gatewayCmd.AddCommand(&cobra.Command{
@@ -265,7 +267,9 @@ func initCloudNetworkCommand(cloudCmd *cobra.Command) {
 	editLoadbalancerCmd.Flags().StringVar(&cloud.CloudLoadbalancerUpdateSpec.Name, "name", "", "Name of the loadbalancer")
 	editLoadbalancerCmd.Flags().StringVar(&cloud.CloudLoadbalancerUpdateSpec.Description, "description", "", "Description of the loadbalancer")
 	editLoadbalancerCmd.Flags().StringVar(&cloud.CloudLoadbalancerUpdateSpec.FlavorId, "flavor", "", "Flavor ID of the loadbalancer (can be retrieved with 'cloud reference loadbalancer list-flavors <region>')")
+	editLoadbalancerCmd.RegisterFlagCompletionFunc("flavor", cloud.CompleteLoadbalancerFlavor)
 	addInteractiveEditorFlag(editLoadbalancerCmd)
+	addEditFlags(editLoadbalancerCmd)
 	loadbalancerCmd.AddCommand(editLoadbalancerCmd)
 }
 
@@ -336,7 +340,7 @@ There are three ways to define the parameters:
 	addInitParameterFileFlag(privateNetworkCreateCmd, assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/region/{regionName}/network", "post", cloud.PrivateNetworkCreationExample, nil)
 	addInteractiveEditorFlag(privateNetworkCreateCmd)
 	addFromFileFlag(privateNetworkCreateCmd)
-	privateNetworkCreateCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for network creation to be done before exiting")
+	addWaitFlags(privateNetworkCreateCmd, "Wait for network creation to be done before exiting")
 	privateNetworkCreateCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
 
 	return privateNetworkCreateCmd
@@ -393,6 +397,7 @@ There are three ways to define the parameters:
 	privateNetworkSubnetCreateCmd.Flags().StringVar(&cloud.CloudNetworkSubnetSpec.Start, "start", "", "First IP for this region (eg: 192.168.1.12)")
 	privateNetworkSubnetCreateCmd.Flags().StringVar(&cloud.CloudNetworkSubnetSpec.End, "end", "", "Last IP for this region (eg: 192.168.1.24)")
 	privateNetworkSubnetCreateCmd.Flags().StringVar(&cloud.CloudNetworkSubnetSpec.Region, "region", "", "Region for the subnet")
+	privateNetworkSubnetCreateCmd.RegisterFlagCompletionFunc("region", cloud.CompleteRegion)
 
 	// Common flags for other means to define parameters
 	addInitParameterFileFlag(privateNetworkSubnetCreateCmd, assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/network/private/{networkId}/subnet", "post", cloud.PrivateNetworkSubnetCreationExample, nil)
@@ -479,7 +484,7 @@ There are three ways to define the parameters:
 	addInitParameterFileFlag(gatewayCreateCmd, assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/region/{regionName}/gateway", "post", cloud.GatewayCreationExample, nil)
 	addInteractiveEditorFlag(gatewayCreateCmd)
 	addFromFileFlag(gatewayCreateCmd)
-	gatewayCreateCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for gateway creation to be done before exiting")
+	addWaitFlags(gatewayCreateCmd, "Wait for gateway creation to be done before exiting")
 	gatewayCreateCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
 
 	// Add a flag to specify the network ID if creating in an existing private network
@@ -46,8 +46,27 @@ func init() {
 	editCloudProjectCmd.Flags().StringVar(&cloud.CloudProjectSpec.Description, "description", "", "Description of the project")
 	editCloudProjectCmd.Flags().BoolVar(&cloud.CloudProjectSpec.ManualQuota, "manual-quota", false, "Prevent automatic quota upgrade")
 	addInteractiveEditorFlag(editCloudProjectCmd)
+	addEditFlags(editCloudProjectCmd)
 	cloudprojectCmd.AddCommand(editCloudProjectCmd)
 
+	// Command to export the resources of a CloudProject
+	exportCloudProjectCmd := &cobra.Command{
+		Use:   "export <project_id>",
+		Short: "Export the resources of the given cloud project as editable YAML documents",
+		Long: `Export the resources of the given cloud project as YAML documents, one per resource, written
+in a directory per kind of resource (instances, volumes, networks, kube-clusters, databases...).
+
+The documents only contain the parameters given at the creation of the resources, the read-only
+fields being stripped, so they can be used for documentation, audits or to re-create the resources
+in another project or region. Existing documents are overwritten.`,
+		Example: `ovhcloud cloud project export <project_id>
+ovhcloud cloud project export <project_id> --dir out/`,
+		Args: cobra.ExactArgs(1),
+		Run:  cloud.ExportCloudProject,
+	}
+	exportCloudProjectCmd.Flags().StringVar(&cloud.CloudProjectExportDir, "dir", "", "Directory where the resources are exported (defaults to the project ID)")
+	cloudprojectCmd.AddCommand(exportCloudProjectCmd)
+
 	// Project management commands
 	cloudprojectCmd.AddCommand(&cobra.Command{
 		Use:   "service-info",
@@ -119,6 +138,7 @@ func init() {
 	initCloudSavingsPlanCommand(cloudCmd)
 	initCloudIPFailoverCommand(cloudCmd)
 	initCloudAlertingCommand(cloudCmd)
+	initCloudApplyCommand(cloudCmd)
 
 	cloudCmd.AddCommand(cloudprojectCmd)
 	rootCmd.AddCommand(cloudCmd)
@@ -5,6 +5,12 @@
 package cmd_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
 	"github.com/jarcoal/httpmock"
 	"github.com/maxatome/go-testdeep/td"
 	"github.com/ovh/ovhcloud-cli/internal/cmd"
@@ -261,3 +267,217 @@ func (ms *MockSuite) TestCloudProjectUnleashCmd(assert, require *td.T) {
 	assert.Cmp(out, td.Contains("✅"))
 	assert.Cmp(out, td.Contains("Project unleashed successfully"))
 }
+
+func (ms *MockSuite) TestCloudProjectExportCmd(assert, require *td.T) {
+	dir := filepath.Join(assert.TempDir(), "export")
+
+	for _, path := range []string{
+		"/volume",
+		"/snapshot",
+		"/volume/snapshot",
+		"/region/GRA11/gateway",
+		"/region/GRA11/loadbalancing/loadbalancer",
+		"/database/service",
+		"/containerRegistry",
+		"/user",
+		"/alerting",
+	} {
+		httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID"+path,
+			httpmock.NewStringResponder(200, `[]`))
+	}
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region",
+		httpmock.NewStringResponder(200, `["GRA11"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11",
+		httpmock.NewStringResponder(200, `{
+			"name": "GRA11",
+			"type": "region",
+			"status": "UP",
+			"services": [
+				{"name": "network", "status": "UP"},
+				{"name": "octavialoadbalancer", "status": "UP"},
+				{"name": "storage-s3-standard", "status": "UP"}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance",
+		httpmock.NewStringResponder(200, `[{
+			"id": "instance-1",
+			"name": "web-1",
+			"region": "GRA11",
+			"flavorId": "flavor-1",
+			"imageId": "image-1",
+			"sshKeyId": "key-1",
+			"status": "ACTIVE",
+			"created": "2025-01-01T00:00:00Z",
+			"monthlyBilling": null,
+			"ipAddresses": [{"ip": "51.68.0.12", "type": "public", "version": 4}]
+		}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private",
+		httpmock.NewStringResponder(200, `[{
+			"id": "pn-123456",
+			"name": "backend",
+			"vlanId": 10,
+			"type": "private",
+			"status": "ACTIVE",
+			"regions": [{"region": "GRA11", "openstackId": "6d7a4a0e-9b09-11f0-993b-0050568ce122", "status": "ACTIVE"}]
+		}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private/pn-123456/subnet",
+		httpmock.NewStringResponder(200, `[{
+			"id": "subnet-12345",
+			"cidr": "10.0.0.0/24",
+			"gatewayIp": "10.0.0.1",
+			"ipPools": [{"region": "GRA11", "start": "10.0.0.10", "end": "10.0.0.200", "dhcp": true, "network": "10.0.0.0/24"}]
+		}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube",
+		httpmock.NewStringResponder(200, `["kube-1"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1",
+		httpmock.NewStringResponder(200, `{
+			"id": "kube-1",
+			"name": "prod",
+			"region": "GRA11",
+			"version": "1.31",
+			"status": "READY",
+			"url": "xxxxxx.c1.gra.k8s.ovh.net",
+			"privateNetworkId": "6d7a4a0e-9b09-11f0-993b-0050568ce122",
+			"updatePolicy": "ALWAYS_UPDATE",
+			"createdAt": "2025-01-01T00:00:00Z"
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1/customization",
+		httpmock.NewStringResponder(200, `{"apiServer": {"admissionPlugins": {"enabled": ["NodeRestriction"], "disabled": ["AlwaysPullImages"]}}}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1/nodepool",
+		httpmock.NewStringResponder(200, `[{
+			"id": "nodepool-1",
+			"projectId": "fakeProjectID",
+			"name": "default",
+			"flavor": "b3-8",
+			"status": "READY",
+			"desiredNodes": 3,
+			"minNodes": 1,
+			"maxNodes": 5,
+			"currentNodes": 3,
+			"autoscale": true,
+			"monthlyBilled": false,
+			"antiAffinity": false,
+			"createdAt": "2025-01-01T00:00:00Z"
+		}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1/openIdConnect",
+		httpmock.NewStringResponder(200, `{"issuerUrl": "https://issuer.example.com", "clientId": "kube", "usernameClaim": "email", "status": "READY"}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/kube/kube-1/ipRestrictions",
+		httpmock.NewStringResponder(200, `["10.0.0.0/8", "192.168.1.0/24"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/storage",
+		httpmock.NewStringResponder(200, `[{"name": "backups", "region": "GRA11", "objectsCount": 12, "objectsSize": 1024}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/storage/backups",
+		httpmock.NewStringResponder(200, `{
+			"name": "backups",
+			"region": "GRA11",
+			"ownerId": 1234,
+			"objectsCount": 12,
+			"objectsSize": 1024,
+			"createdAt": "2025-01-01T00:00:00Z",
+			"virtualHost": "https://backups.s3.gra.io.cloud.ovh.net",
+			"versioning": {"status": "enabled"}
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/region/GRA11/storage/backups/lifecycle",
+		httpmock.NewStringResponder(200, `{"rules": [{"id": "expire-logs", "status": "enabled", "filter": {"prefix": "logs/"}, "expiration": {"days": 30}}]}`))
+
+	out, err := cmd.Execute("cloud", "project", "export", "fakeProjectID", "--dir", dir, "-o", "json")
+	require.CmpNoError(err)
+
+	files := map[string]string{
+		"instances/web-1.yaml": `flavorId: flavor-1
+imageId: image-1
+monthlyBilling: false
+name: web-1
+region: GRA11
+sshKeyId: key-1
+`,
+		"networks/backend.yaml": `name: backend
+regions:
+- GRA11
+vlanId: 10
+`,
+		"subnets/backend-10.0.0.0_24.yaml": `dhcp: true
+end: 10.0.0.200
+network: 10.0.0.0/24
+noGateway: false
+privateNetwork: backend
+region: GRA11
+start: 10.0.0.10
+`,
+		"kube-clusters/prod.yaml": `customization:
+  apiServer:
+    admissionPlugins:
+      disabled:
+      - AlwaysPullImages
+      enabled:
+      - NodeRestriction
+ipRestrictions:
+- 10.0.0.0/8
+- 192.168.1.0/24
+name: prod
+nodepools:
+- antiAffinity: false
+  autoscale: true
+  desiredNodes: 3
+  flavorName: b3-8
+  maxNodes: 5
+  minNodes: 1
+  monthlyBilled: false
+  name: default
+openIdConnect:
+  clientId: kube
+  issuerUrl: https://issuer.example.com
+  usernameClaim: email
+privateNetworkId: 6d7a4a0e-9b09-11f0-993b-0050568ce122
+region: GRA11
+updatePolicy: ALWAYS_UPDATE
+version: "1.31"
+`,
+		"s3-containers/backups.yaml": `lifecycle:
+  rules:
+  - expiration:
+      days: 30
+    filter:
+      prefix: logs/
+    id: expire-logs
+    status: enabled
+name: backups
+ownerId: 1234
+region: GRA11
+versioning:
+  status: enabled
+`,
+	}
+
+	var paths []string
+	for _, name := range []string{
+		"instances/web-1.yaml",
+		"networks/backend.yaml",
+		"subnets/backend-10.0.0.0_24.yaml",
+		"kube-clusters/prod.yaml",
+		"s3-containers/backups.yaml",
+	} {
+		paths = append(paths, filepath.Join(dir, name))
+	}
+	assert.Cmp(json.RawMessage(out), td.JSON(`{"message": $1, "details": $2}`,
+		fmt.Sprintf("✅ 5 resources of project fakeProjectID exported to %s", dir), paths))
+
+	for name, expected := range files {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		require.CmpNoError(err)
+		assert.String(string(content), expected, name)
+	}
+}
@@ -81,6 +81,7 @@ func getRancherEditCmd() *cobra.Command {
 	}
 
 	addInteractiveEditorFlag(editRancherCmd)
+	addEditFlags(editRancherCmd)
 
 	return editRancherCmd
 }
@@ -140,6 +141,7 @@ There are three ways to define the creation parameters:
 	addInteractiveEditorFlag(rancherCreateCmd)
 	addFromFileFlag(rancherCreateCmd)
 	rancherCreateCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
+	addWaitFlags(rancherCreateCmd, "Wait for the managed Rancher service to be ready before exiting")
 
 	return rancherCreateCmd
 }
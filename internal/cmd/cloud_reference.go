@@ -22,12 +22,13 @@ func initCloudReferenceCmd(cloudCmd *cobra.Command) {
 	flavorListCmd := withFilterFlag(&cobra.Command{
 		Use:   "list-flavors",
 		Short: "List available flavors in the given cloud project",
-		Run: func(_ *cobra.Command, _ []string) {
-			cloud.GetFlavors(region)
+		Run: func(cmd *cobra.Command, _ []string) {
+			cloud.GetFlavors(cmd.Context(), region)
 		},
 		Args: cobra.NoArgs,
 	})
 	flavorListCmd.Flags().StringVarP(&region, "region", "r", "", "Region to filter flavors (e.g., GRA9, BHS5)")
+	flavorListCmd.RegisterFlagCompletionFunc("region", cloud.CompleteRegion)
 	referenceCmd.AddCommand(flavorListCmd)
 
 	// Images
@@ -35,12 +36,13 @@ func initCloudReferenceCmd(cloudCmd *cobra.Command) {
 	imageListCmd := withFilterFlag(&cobra.Command{
 		Use:   "list-images",
 		Short: "List available images in the given cloud project",
-		Run: func(_ *cobra.Command, _ []string) {
-			cloud.GetImages(region, osType)
+		Run: func(cmd *cobra.Command, _ []string) {
+			cloud.GetImages(cmd.Context(), region, osType)
 		},
 		Args: cobra.NoArgs,
 	})
 	imageListCmd.Flags().StringVarP(&region, "region", "r", "", "Region to filter images (e.g., GRA9, BHS5)")
+	imageListCmd.RegisterFlagCompletionFunc("region", cloud.CompleteRegion)
 	imageListCmd.Flags().StringVarP(&osType, "os-type", "t", "", "OS type to filter images (baremetal-linux, bsd, linux, windows)")
 	referenceCmd.AddCommand(imageListCmd)
 
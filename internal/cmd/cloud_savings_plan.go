@@ -88,6 +88,7 @@ Deployment types:
 	subscribeCmd.Flags().StringVar(&cloud.SavingsPlanSubscribeSpec.DisplayName, "display-name", "", "Custom display name (required)")
 	subscribeCmd.Flags().StringVar(&cloud.SavingsPlanSubscribeSpec.OfferID, "offer-id", "", "Offer ID from list-offers (alternative to --flavor)")
 	subscribeCmd.Flags().StringVar(&cloud.SavingsPlanSubscribeSpec.Flavor, "flavor", "", "Savings plan flavor (e.g., b3-8, rancher, c3-16)")
+	subscribeCmd.RegisterFlagCompletionFunc("flavor", cloud.CompleteSavingsPlanFlavor)
 	subscribeCmd.Flags().StringVar(&cloud.SavingsPlanSubscribeSpec.DeploymentType, "deployment-type", "1AZ", "Deployment type: 1AZ or 3AZ (default: 1AZ)")
 	subscribeCmd.Flags().IntVar(&cloud.SavingsPlanSubscribeSpec.Size, "size", 0, "Size of the savings plan (required)")
 	subscribeCmd.Flags().StringVar(&cloud.SavingsPlanSubscribeSpec.StartDate, "start-date", "", "Start date (YYYY-MM-DD format, defaults to today)")
@@ -106,6 +107,7 @@ You can use either --offer-id or --flavor with --deployment-type.`,
 	simulateCmd.Flags().StringVar(&cloud.SavingsPlanSubscribeSpec.DisplayName, "display-name", "", "Custom display name (required)")
 	simulateCmd.Flags().StringVar(&cloud.SavingsPlanSubscribeSpec.OfferID, "offer-id", "", "Offer ID from list-offers (alternative to --flavor)")
 	simulateCmd.Flags().StringVar(&cloud.SavingsPlanSubscribeSpec.Flavor, "flavor", "", "Savings plan flavor (e.g., b3-8, rancher, c3-16)")
+	simulateCmd.RegisterFlagCompletionFunc("flavor", cloud.CompleteSavingsPlanFlavor)
 	simulateCmd.Flags().StringVar(&cloud.SavingsPlanSubscribeSpec.DeploymentType, "deployment-type", "1AZ", "Deployment type: 1AZ or 3AZ (default: 1AZ)")
 	simulateCmd.Flags().IntVar(&cloud.SavingsPlanSubscribeSpec.Size, "size", 0, "Size of the savings plan (required)")
 	simulateCmd.Flags().StringVar(&cloud.SavingsPlanSubscribeSpec.StartDate, "start-date", "", "Start date (YYYY-MM-DD format, defaults to today)")
@@ -5,6 +5,7 @@
 package cmd_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"net/http"
 
@@ -402,3 +403,24 @@ func (ms *MockSuite) TestCloudSavingsPlanListPeriodsCmd(assert, require *td.T) {
 	assert.Contains(out, "2026-01-31")
 	assert.Contains(out, "2027-01-31")
 }
+
+func (ms *MockSuite) TestCloudSavingsPlanSubscribeCmdFlavorCompletion(assert, require *td.T) {
+	require.Setenv("XDG_CACHE_HOME", require.TempDir())
+
+	httpmock.RegisterResponder(http.MethodGet,
+		"https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/flavor",
+		httpmock.NewStringResponder(200, `[
+			{"id": "flavor-1", "name": "b3-8", "region": "GRA11"},
+			{"id": "flavor-2", "name": "b3-8", "region": "SBG5"},
+			{"id": "flavor-3", "name": "c3-16", "region": "GRA11"}
+		]`),
+	)
+
+	var out bytes.Buffer
+	cmd.GetRootCommand().SetOut(&out)
+	defer cmd.GetRootCommand().SetOut(nil)
+
+	_, err := cmd.Execute("__complete", "cloud", "savings-plan", "subscribe", "--cloud-project", "fakeProjectID", "--flavor", "")
+	require.CmpNoError(err)
+	assert.String(out.String(), "rancher\nb3-8\nc3-16\n:4\n")
+}
@@ -6,7 +6,6 @@ package cmd
 
 import (
 	"github.com/ovh/ovhcloud-cli/internal/assets"
-	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
 	"github.com/spf13/cobra"
 )
@@ -42,6 +41,7 @@ func initCloudVolumeCommand(cloudCmd *cobra.Command) {
 	volumeEditCmd.Flags().StringVar(&cloud.VolumeSpec.Description, "description", "", "Volume description")
 	volumeEditCmd.Flags().StringVar(&cloud.VolumeSpec.Name, "name", "", "Volume name")
 	addInteractiveEditorFlag(volumeEditCmd)
+	addEditFlags(volumeEditCmd)
 	storageBlockCmd.AddCommand(volumeEditCmd)
 
 	storageBlockCmd.AddCommand(getVolumeCreateCmd())
@@ -54,19 +54,23 @@ func initCloudVolumeCommand(cloudCmd *cobra.Command) {
 	})
 
 	// Volume action commands
-	storageBlockCmd.AddCommand(&cobra.Command{
+	volumeAttachCmd := &cobra.Command{
 		Use:   "attach <volume_id> <instance_id>",
 		Short: "Attach the given volume to the given instance",
 		Run:   cloud.AttachVolumeToInstance,
 		Args:  cobra.ExactArgs(2),
-	})
+	}
+	addWaitFlags(volumeAttachCmd, "Wait for the volume to be attached before exiting")
+	storageBlockCmd.AddCommand(volumeAttachCmd)
 
-	storageBlockCmd.AddCommand(&cobra.Command{
+	volumeDetachCmd := &cobra.Command{
 		Use:   "detach <volume_id> <instance_id>",
 		Short: "Detach the given volume from the given instance",
 		Run:   cloud.DetachVolumeFromInstance,
 		Args:  cobra.ExactArgs(2),
-	})
+	}
+	addWaitFlags(volumeDetachCmd, "Wait for the volume to be detached before exiting")
+	storageBlockCmd.AddCommand(volumeDetachCmd)
 
 	storageBlockCmd.AddCommand(&cobra.Command{
 		Use:   "upsize <volume_id> <new_size (GB)>",
@@ -181,7 +185,7 @@ func getVolumeCreateCmd() *cobra.Command {
 	addInitParameterFileFlag(volumeCreateCmd, assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/region/{regionName}/volume", "post", cloud.VolumeCreateExample, nil)
 	addInteractiveEditorFlag(volumeCreateCmd)
 	addFromFileFlag(volumeCreateCmd)
-	volumeCreateCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for volume creation to be done before exiting")
+	addWaitFlags(volumeCreateCmd, "Wait for volume creation to be done before exiting")
 	volumeCreateCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
 
 	return volumeCreateCmd
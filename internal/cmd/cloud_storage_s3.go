@@ -47,6 +47,7 @@ func initCloudStorageS3Command(cloudCmd *cobra.Command) {
 	editStorageS3Cmd.Flags().StringToStringVar(&cloud.StorageS3Spec.Tags, "tag", nil, "Container tags as key=value pairs")
 	editStorageS3Cmd.Flags().StringVar(&cloud.StorageS3Spec.Versioning.Status, "versioning-status", "", "Versioning status (disabled, enabled, suspended)")
 	addInteractiveEditorFlag(editStorageS3Cmd)
+	addEditFlags(editStorageS3Cmd)
 	storageS3Cmd.AddCommand(editStorageS3Cmd)
 
 	storageS3Cmd.AddCommand(getCloudStorageS3CreateCmd())
@@ -111,6 +112,7 @@ func initCloudStorageS3Command(cloudCmd *cobra.Command) {
 	objectEditCmd.Flags().StringVar(&cloud.StorageS3ObjectSpec.Lock.Mode, "lock-mode", "", "Lock mode (compliance, governance)")
 	objectEditCmd.Flags().StringVar(&cloud.StorageS3ObjectSpec.Lock.RetainUntil, "lock-retain-until", "", "Lock retain until date (e.g., 2024-12-31T23:59:59Z)")
 	addInteractiveEditorFlag(objectEditCmd)
+	addEditFlags(objectEditCmd)
 	objectCmd.AddCommand(objectEditCmd)
 
 	objectCmd.AddCommand(&cobra.Command{
@@ -178,6 +180,7 @@ func initCloudStorageS3Command(cloudCmd *cobra.Command) {
 	objectVersionEditCmd.Flags().StringVar(&cloud.StorageS3ObjectSpec.Lock.Mode, "lock-mode", "", "Lock mode (compliance, governance)")
 	objectVersionEditCmd.Flags().StringVar(&cloud.StorageS3ObjectSpec.Lock.RetainUntil, "lock-retain-until", "", "Lock retain until date (e.g., 2024-12-31T23:59:59Z)")
 	addInteractiveEditorFlag(objectVersionEditCmd)
+	addEditFlags(objectVersionEditCmd)
 	objectVersionCmd.AddCommand(objectVersionEditCmd)
 
 	objectVersionCmd.AddCommand(&cobra.Command{
@@ -258,6 +261,7 @@ func initCloudStorageS3Command(cloudCmd *cobra.Command) {
 	}
 	addInitParameterFileFlag(lifecycleEditCmd, assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/region/{regionName}/storage/{name}/lifecycle", "put", cloud.CloudStorageS3LifecycleExample, nil)
 	addInteractiveEditorFlag(lifecycleEditCmd)
+	addEditFlags(lifecycleEditCmd)
 	addFromFileFlag(lifecycleEditCmd)
 	lifecycleEditCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
 	lifecycleCmd.AddCommand(lifecycleEditCmd)
@@ -350,6 +354,8 @@ func initCloudStorageS3Command(cloudCmd *cobra.Command) {
 		Args:  cobra.ExactArgs(2),
 	})
 
+	addArgumentCompletion(storageS3Cmd, "<container_name>", cloud.CompleteStorageS3ContainerName)
+
 	cloudCmd.AddCommand(storageS3Cmd)
 }
 
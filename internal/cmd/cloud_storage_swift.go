@@ -39,6 +39,7 @@ func initCloudStorageSwiftCommand(cloudCmd *cobra.Command) {
 	}
 	editCmd.Flags().StringVar(&cloud.CloudSwiftContainerType, "type", "", "Type of the SWIFT storage container (private, public, static)")
 	addInteractiveEditorFlag(editCmd)
+	addEditFlags(editCmd)
 	storageSwiftCmd.AddCommand(editCmd)
 
 	cloudCmd.AddCommand(storageSwiftCmd)
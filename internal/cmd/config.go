@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/config"
 	"github.com/spf13/cobra"
 )
@@ -21,27 +22,91 @@ func init() {
 	// Command to show the full config
 	configCmd.AddCommand(&cobra.Command{
 		Use:   "show",
-		Short: "Show CLI configuration",
+		Short: "Show CLI configuration, with the source of each value",
 		Run:   config.ShowConfig,
 	})
 
-	configCmd.AddCommand(&cobra.Command{
-		Example:               "ovhcloud config set default_cloud_project xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx",
-		Use:                   "set <configuration key> <configuration value>",
-		Short:                 "Set a value in the CLI configuration",
-		Run:                   config.SetConfig,
-		Args:                  cobra.ExactArgs(2),
-		DisableFlagsInUseLine: true,
-	})
+	setCmd := &cobra.Command{
+		Example: `ovhcloud config set default_cloud_project xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+ovhcloud config set default_cloud_project xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx --scope local`,
+		Use:   "set <configuration key> <configuration value>",
+		Short: "Set a value in the CLI configuration",
+		Run:   config.SetConfig,
+		Args:  cobra.ExactArgs(2),
+	}
+	addConfigScopeFlag(setCmd)
+	configCmd.AddCommand(setCmd)
 
-	configCmd.AddCommand(&cobra.Command{
-		Example:               "ovhcloud config set-endpoint EU",
-		Use:                   "set-endpoint <region>",
-		Short:                 "Configure CLI to use the given API endpoint (EU, CA, US), or a specific URL (e.g. https://eu.api.ovh.com/v1)",
-		Run:                   config.SetEndpoint,
-		Args:                  cobra.ExactArgs(1),
-		DisableFlagsInUseLine: true,
+	setEndpointCmd := &cobra.Command{
+		Example: "ovhcloud config set-endpoint EU",
+		Use:     "set-endpoint <region>",
+		Short:   "Configure CLI to use the given API endpoint (EU, CA, US), or a specific URL (e.g. https://eu.api.ovh.com/v1)",
+		Run:     config.SetEndpoint,
+		Args:    cobra.ExactArgs(1),
+	}
+	addConfigScopeFlag(setEndpointCmd)
+	configCmd.AddCommand(setEndpointCmd)
+
+	// Profile commands
+	profileCmd := &cobra.Command{
+		Use:   "profile",
+		Short: "Manage the profiles used to switch between accounts and API endpoints",
+	}
+	configCmd.AddCommand(profileCmd)
+
+	profileCmd.AddCommand(&cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List configured profiles",
+		Run:     config.ListProfiles,
 	})
 
+	profileAddCmd := &cobra.Command{
+		Example: `ovhcloud config profile add sandbox --endpoint CA --client-id xxx --client-secret yyy
+ovhcloud config profile add production --endpoint EU --application-key xxx --application-secret yyy --consumer-key zzz`,
+		Use:   "add <profile_name>",
+		Short: "Add a new profile",
+		Run:   config.AddProfile,
+		Args:  cobra.ExactArgs(1),
+	}
+	profileAddCmd.Flags().StringVar(&config.ProfileSpec.Endpoint, "endpoint", "", "API endpoint of the profile (EU, CA, US), or a specific URL (defaults to EU)")
+	profileAddCmd.Flags().StringVar(&config.ProfileSpec.ApplicationKey, "application-key", "", "Application key")
+	profileAddCmd.Flags().StringVar(&config.ProfileSpec.ApplicationSecret, "application-secret", "", "Application secret")
+	profileAddCmd.Flags().StringVar(&config.ProfileSpec.ConsumerKey, "consumer-key", "", "Consumer key")
+	profileAddCmd.Flags().StringVar(&config.ProfileSpec.ClientID, "client-id", "", "OAuth2 client ID")
+	profileAddCmd.Flags().StringVar(&config.ProfileSpec.ClientSecret, "client-secret", "", "OAuth2 client secret")
+	profileAddCmd.Flags().StringVar(&config.ProfileSpec.AccessToken, "access-token", "", "Access token")
+	profileAddCmd.Flags().StringVar(&config.ProfileSpec.DefaultCloudProject, "default-cloud-project", "", "Default Public Cloud project of the profile")
+	profileAddCmd.MarkFlagsRequiredTogether("application-key", "application-secret")
+	profileAddCmd.MarkFlagsRequiredTogether("client-id", "client-secret")
+	profileAddCmd.MarkFlagsMutuallyExclusive("application-key", "client-id", "access-token")
+	addConfigScopeFlag(profileAddCmd)
+	profileCmd.AddCommand(profileAddCmd)
+
+	profileRemoveCmd := &cobra.Command{
+		Use:   "remove <profile_name>",
+		Short: "Remove a profile",
+		Run:   config.RemoveProfile,
+		Args:  cobra.ExactArgs(1),
+	}
+	addConfigScopeFlag(profileRemoveCmd)
+	profileCmd.AddCommand(profileRemoveCmd)
+
+	profileUseCmd := &cobra.Command{
+		Example: "ovhcloud config profile use sandbox",
+		Use:     "use <profile_name>",
+		Short:   "Set the profile used by default",
+		Run:     config.UseProfile,
+		Args:    cobra.ExactArgs(1),
+	}
+	addConfigScopeFlag(profileUseCmd)
+	profileCmd.AddCommand(profileUseCmd)
+
 	rootCmd.AddCommand(configCmd)
 }
+
+// addConfigScopeFlag adds the flag used to select the configuration file to write to
+func addConfigScopeFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&flags.ConfigScope, "scope", "", `Configuration file to write to: system (/etc/ovh.conf), user (~/.ovh.conf) or local (./ovh.conf).
+Defaults to the file already defining the value, or the one having the highest priority`)
+}
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+	"github.com/ovh/ovhcloud-cli/internal/config"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httplib "github.com/ovh/ovhcloud-cli/internal/http"
+)
+
+// withTempConfig replaces the configuration files by the ones of a temporary
+// directory, initialized with the given content for each scope (system, user
+// or local). The original configuration and API client (that may be
+// re-initialized when switching profiles) are restored at the end of the test.
+func withTempConfig(t *td.T, contents map[string]string) string {
+	dir := t.TempDir()
+
+	previousPaths, previousLayers := config.ConfigPaths, config.Layers
+	previousConfig, previousConfigPath := flags.CliConfig, flags.CliConfigPath
+	previousClient, previousProfile := httplib.Client, httplib.ClientProfile
+	t.Cleanup(func() {
+		config.ConfigPaths, config.Layers = previousPaths, previousLayers
+		flags.CliConfig, flags.CliConfigPath = previousConfig, previousConfigPath
+		httplib.Client, httplib.ClientProfile = previousClient, previousProfile
+		config.ActiveProfile = previousProfile
+	})
+
+	config.ConfigPaths = nil
+	for _, scope := range config.ConfigScopes {
+		path := filepath.Join(dir, scope+".conf")
+		config.ConfigPaths = append(config.ConfigPaths, path)
+
+		if content, ok := contents[scope]; ok {
+			t.CmpNoError(os.WriteFile(path, []byte(content), 0600))
+		}
+	}
+	flags.CliConfig, flags.CliConfigPath = config.LoadINI()
+
+	return dir
+}
+
+func (ms *MockSuite) TestConfigShowCmd(assert, require *td.T) {
+	dir := withTempConfig(require, map[string]string{
+		"system": "[default]\nendpoint = ovh-ca\n",
+		"user":   "[default]\nendpoint = ovh-eu\n\n[ovh-eu]\nclient_id = my_client_id\n",
+		"local":  "[ovh-cli]\ndefault_cloud_project = fakeProjectID\n",
+	})
+
+	out, err := cmd.Execute("config", "show")
+
+	require.CmpNoError(err)
+	assert.Contains(out, "│ endpoint              │ ovh-eu        │ "+filepath.Join(dir, "user.conf"))
+	assert.Contains(out, "│ client_id             │ my_client_id  │ "+filepath.Join(dir, "user.conf"))
+	assert.Contains(out, "│ default_cloud_project │ fakeProjectID │ "+filepath.Join(dir, "local.conf"))
+}
+
+func (ms *MockSuite) TestConfigSetCmdWithScope(assert, require *td.T) {
+	dir := withTempConfig(require, map[string]string{
+		"user": "[ovh-cli]\ndefault_cloud_project = userProjectID\n",
+	})
+
+	_, err := cmd.Execute("config", "set", "default_cloud_project", "localProjectID", "--scope", "local")
+	require.CmpNoError(err)
+
+	content, err := os.ReadFile(filepath.Join(dir, "local.conf"))
+	require.CmpNoError(err)
+	assert.String(string(content), "[ovh-cli]\ndefault_cloud_project = localProjectID\n")
+
+	content, err = os.ReadFile(filepath.Join(dir, "user.conf"))
+	require.CmpNoError(err)
+	assert.String(string(content), "[ovh-cli]\ndefault_cloud_project = userProjectID\n")
+
+	value, source := config.GetEffectiveValue(flags.CliConfig, "ovh-cli", "default_cloud_project")
+	assert.String(value, "localProjectID")
+	assert.String(source, filepath.Join(dir, "local.conf"))
+
+	// Without scope, the value is written in the file already defining it
+	_, err = cmd.Execute("config", "set", "default_cloud_project", "otherProjectID")
+	require.CmpNoError(err)
+
+	content, err = os.ReadFile(filepath.Join(dir, "local.conf"))
+	require.CmpNoError(err)
+	assert.String(string(content), "[ovh-cli]\ndefault_cloud_project = otherProjectID\n")
+}
+
+func (ms *MockSuite) TestConfigProfileAddCmd(assert, require *td.T) {
+	dir := withTempConfig(require, nil)
+
+	out, err := cmd.Execute("config", "profile", "add", "sandbox", "--endpoint", "CA",
+		"--client-id", "my_client_id", "--client-secret", "my_client_secret", "--default-cloud-project", "fakeProjectID")
+
+	require.CmpNoError(err)
+	assert.String(out, `✅ Profile sandbox added`)
+
+	content, err := os.ReadFile(filepath.Join(dir, "user.conf"))
+	require.CmpNoError(err)
+	assert.String(string(content), `[profile sandbox]
+endpoint              = ovh-ca
+client_id             = my_client_id
+client_secret         = my_client_secret
+default_cloud_project = fakeProjectID
+`)
+}
+
+func (ms *MockSuite) TestConfigProfileListCmd(assert, require *td.T) {
+	withTempConfig(require, map[string]string{
+		"user": `[profile production]
+endpoint = ovh-eu
+application_key = app_key
+
+[profile sandbox]
+endpoint = ovh-ca
+client_id = client_id
+
+[ovh-cli]
+profile = sandbox
+`,
+	})
+
+	out, err := cmd.Execute("config", "profile", "list", "-o", "json")
+
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`[
+		{
+			"name": "production",
+			"active": false,
+			"endpoint": "ovh-eu",
+			"authentication": "application_key",
+			"default_cloud_project": ""
+		},
+		{
+			"name": "sandbox",
+			"active": true,
+			"endpoint": "ovh-ca",
+			"authentication": "oauth2",
+			"default_cloud_project": ""
+		}
+	]`))
+}
+
+func (ms *MockSuite) TestConfigProfileUseAndRemoveCmd(assert, require *td.T) {
+	withTempConfig(require, map[string]string{
+		"user": "[profile sandbox]\nendpoint = ovh-ca\n",
+	})
+
+	out, err := cmd.Execute("config", "profile", "use", "sandbox")
+	require.CmpNoError(err)
+	assert.String(out, `✅ Profile sandbox is now active`)
+	assert.String(flags.CliConfig.Section("ovh-cli").Key("profile").String(), "sandbox")
+
+	out, err = cmd.Execute("config", "profile", "remove", "sandbox")
+	require.CmpNoError(err)
+	assert.String(out, `✅ Profile sandbox removed`)
+	assert.False(flags.CliConfig.Section("ovh-cli").HasKey("profile"))
+	assert.False(flags.CliConfig.HasSection("profile sandbox"))
+}
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/dedicatedceph"
 	"github.com/spf13/cobra"
 )
@@ -42,7 +43,10 @@ func init() {
 	editCmd.Flags().StringVar(&dedicatedceph.DedicatedCephSpec.CrushTunables, "crush-tunables", "", "Tunables of cluster (ARGONAUT, BOBTAIL, DEFAULT, FIREFLY, HAMMER, JEWEL, LEGACY, OPTIMAL)")
 	editCmd.Flags().StringVar(&dedicatedceph.DedicatedCephSpec.Label, "label", "", "Name of the cluster")
 	addInteractiveEditorFlag(editCmd)
+	addEditFlags(editCmd)
 	dedicatedcephCmd.AddCommand(editCmd)
 
+	addArgumentCompletion(dedicatedcephCmd, "<service_name>", common.CompleteFromEndpoint("/v1/dedicated/ceph", "", false))
+
 	rootCmd.AddCommand(dedicatedcephCmd)
 }
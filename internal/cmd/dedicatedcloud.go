@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/dedicatedcloud"
 	"github.com/spf13/cobra"
 )
@@ -32,5 +33,7 @@ func init() {
 		Run:   dedicatedcloud.GetDedicatedCloud,
 	})
 
+	addArgumentCompletion(dedicatedcloudCmd, "<service_name>", common.CompleteFromEndpoint("/v1/dedicatedCloud", "", false))
+
 	rootCmd.AddCommand(dedicatedcloudCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/dedicatedcluster"
 	"github.com/spf13/cobra"
 )
@@ -32,5 +33,7 @@ func init() {
 		Run:   dedicatedcluster.GetDedicatedCluster,
 	})
 
+	addArgumentCompletion(dedicatedclusterCmd, "<service_name>", common.CompleteFromEndpoint("/v1/dedicated/cluster", "", false))
+
 	rootCmd.AddCommand(dedicatedclusterCmd)
 }
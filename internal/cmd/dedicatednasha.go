@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/dedicatednasha"
 	"github.com/spf13/cobra"
 )
@@ -42,7 +43,10 @@ func init() {
 	editDedicatednashaCmd.Flags().StringVar(&dedicatednasha.DedicatedNasHASpec.CustomName, "custom-name", "", "Custom name for the Dedicated NasHA")
 	editDedicatednashaCmd.Flags().BoolVar(&dedicatednasha.DedicatedNasHASpec.Monitored, "monitored", false, "Send an email to customer if any issue is detected")
 	addInteractiveEditorFlag(editDedicatednashaCmd)
+	addEditFlags(editDedicatednashaCmd)
 	dedicatednashaCmd.AddCommand(editDedicatednashaCmd)
 
+	addArgumentCompletion(dedicatednashaCmd, "<service_name>", common.CompleteFromEndpoint("/v1/dedicated/nasha", "", false))
+
 	rootCmd.AddCommand(dedicatednashaCmd)
 }
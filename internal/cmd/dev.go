@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/ovh/ovhcloud-cli/internal/services/dev"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	devCmd := &cobra.Command{
+		Use:   "dev",
+		Short: "Tools to develop and test scripts using the CLI",
+	}
+
+	// Disable parent pre-run that verifies if the API client is correctly initialized
+	devCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {}
+
+	mockServerCmd := &cobra.Command{
+		Use:   "mock-server",
+		Short: "Start a local API server answering with example responses generated from the API schemas",
+		Long: `Start a local API server serving all the paths of the API schemas embedded in the CLI.
+
+Responses are the examples documented in the schemas, or values synthesized from the
+response schemas. Resources created with POST calls are kept in memory, and can then be
+fetched, listed, updated and deleted until the server stops. Lists are paginated using
+the X-Pagination-Size, X-Pagination-Cursor and X-Pagination-Cursor-Next headers.
+
+Credentials are not checked, any credentials can be used to call the server:
+
+	ovhcloud dev mock-server --port 8080
+	ovhcloud config profile add sandbox --endpoint http://localhost:8080 --access-token fake
+	ovhcloud --profile sandbox vps list`,
+		Run:  dev.StartMockServer,
+		Args: cobra.NoArgs,
+	}
+	mockServerCmd.Flags().StringVar(&dev.MockServerHost, "host", "localhost", "Address to listen on")
+	mockServerCmd.Flags().IntVar(&dev.MockServerPort, "port", 8080, "Port to listen on")
+	devCmd.AddCommand(mockServerCmd)
+
+	rootCmd.AddCommand(devCmd)
+}
@@ -42,6 +42,7 @@ func init() {
 	editDomainNameCmd.Flags().StringVar(&domainname.DomainSpec.NameServerType, "name-server-type", "", "Type of name server (anycast, dedicated, empty, external, hold, hosted, hosting, mixed, parking)")
 	editDomainNameCmd.Flags().StringVar(&domainname.DomainSpec.TranferLockStatus, "transfer-lock-status", "", "Transfer lock status (locked, locking, unavailable, unlocked, unlocking)")
 	addInteractiveEditorFlag(editDomainNameCmd)
+	addEditFlags(editDomainNameCmd)
 	domainnameCmd.AddCommand(editDomainNameCmd)
 
 	rootCmd.AddCommand(domainnameCmd)
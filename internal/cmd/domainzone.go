@@ -6,6 +6,7 @@ package cmd
 
 import (
 	"github.com/ovh/ovhcloud-cli/internal/assets"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/domainzone"
 	"github.com/spf13/cobra"
 )
@@ -70,6 +71,7 @@ func init() {
 		Run:   domainzone.CreateRecord,
 	}
 	domainZoneRecordPostCmd.Flags().StringVar(&domainzone.CreateRecordSpec.FieldType, "field-type", "", "Record type (A, AAAA, CAA, CNAME, DKIM, DMARC, DNAME, HTTPS, LOC, MX, NAPTR, NS, PTR, RP, SPF, SRV, SSHFP, SVCB, TLSA, TXT)")
+	domainZoneRecordPostCmd.RegisterFlagCompletionFunc("field-type", common.CompleteFromEnum(assets.DomainOpenapiSchema, "/domain/zone/{zoneName}/record", "post", "fieldType"))
 	domainZoneRecordPostCmd.Flags().StringVar(&domainzone.CreateRecordSpec.SubDomain, "sub-domain", "", "Record subDomain")
 	domainZoneRecordPostCmd.Flags().StringVar(&domainzone.CreateRecordSpec.Target, "target", "", "Target of the record")
 	domainZoneRecordPostCmd.Flags().IntVar(&domainzone.CreateRecordSpec.TTL, "ttl", 0, "TTL of the record")
@@ -93,6 +95,7 @@ func init() {
 
 	addInitParameterFileFlag(domainZoneRecordPutCmd, assets.DomainOpenapiSchema, "/domain/zone/{zoneName}/record/{id}", "put", domainzone.RecordUpdateExample, nil)
 	addInteractiveEditorFlag(domainZoneRecordPutCmd)
+	addEditFlags(domainZoneRecordPutCmd)
 	addFromFileFlag(domainZoneRecordPutCmd)
 	domainZoneRecordPutCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
 
@@ -106,5 +109,7 @@ func init() {
 	}
 	domainZoneRecordCmd.AddCommand(domainZoneRecordDeleteCmd)
 
+	addArgumentCompletion(domainzoneCmd, "<zone_name>", common.CompleteFromEndpoint("/v1/domain/zone", "", false))
+
 	rootCmd.AddCommand(domainzoneCmd)
 }
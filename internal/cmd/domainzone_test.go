@@ -5,6 +5,7 @@
 package cmd_test
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"github.com/jarcoal/httpmock"
@@ -137,3 +138,13 @@ func (ms *MockSuite) TestDomainZoneDeleteRecord(assert, require *td.T) {
 	require.CmpNoError(err)
 	assert.String(out, `✅ record 1 deleted successfully from example.com`)
 }
+
+func (ms *MockSuite) TestDomainZoneRecordCreateCmdFieldTypeCompletion(assert, require *td.T) {
+	var out bytes.Buffer
+	cmd.GetRootCommand().SetOut(&out)
+	defer cmd.GetRootCommand().SetOut(nil)
+
+	_, err := cmd.Execute("__complete", "domain-zone", "record", "create", "example.com", "--field-type", "")
+	require.CmpNoError(err)
+	assert.String(out.String(), "A\nAAAA\nCAA\nCNAME\nDKIM\nDMARC\nDNAME\nLOC\nMX\nNAPTR\nNS\nPTR\nSPF\nSRV\nSSHFP\nTLSA\nTXT\n:4\n")
+}
@@ -6,6 +6,7 @@ package cmd
 
 import (
 	"github.com/ovh/ovhcloud-cli/internal/assets"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/emaildomain"
 	"github.com/spf13/cobra"
 )
@@ -84,5 +85,7 @@ func init() {
 		Run:   emaildomain.DeleteRedirection,
 	})
 
+	addArgumentCompletion(emaildomainCmd, "<service_name>", common.CompleteFromEndpoint("/v1/email/domain", "", false))
+
 	rootCmd.AddCommand(emaildomainCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/emailmxplan"
 	"github.com/spf13/cobra"
 )
@@ -57,7 +58,10 @@ func init() {
 	emailmxplanEditCmd.Flags().BoolVar(&emailmxplan.EmailMXPlanSpec.SpamAndVirusConfiguration.TagSpam, "spam-tag-spam", false, "If message is a spam change its subject")
 	emailmxplanEditCmd.Flags().BoolVar(&emailmxplan.EmailMXPlanSpec.SpamAndVirusConfiguration.TagVirus, "spam-tag-virus", false, "If message is a virus change its subject")
 	addInteractiveEditorFlag(emailmxplanEditCmd)
+	addEditFlags(emailmxplanEditCmd)
 	emailmxplanCmd.AddCommand(emailmxplanEditCmd)
 
+	addArgumentCompletion(emailmxplanCmd, "<service_name>", common.CompleteFromEndpoint("/v1/email/mxplan", "", false))
+
 	rootCmd.AddCommand(emailmxplanCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/emailpro"
 	"github.com/spf13/cobra"
 )
@@ -57,7 +58,10 @@ func init() {
 	emailproCmd.Flags().BoolVar(&emailpro.EmailProSpec.SpamAndVirusConfiguration.TagSpam, "spam-tag-spam", false, "If message is a spam change its subject")
 	emailproCmd.Flags().BoolVar(&emailpro.EmailProSpec.SpamAndVirusConfiguration.TagVirus, "spam-tag-virus", false, "If message is a virus change its subject")
 	addInteractiveEditorFlag(editEmailProCmd)
+	addEditFlags(editEmailProCmd)
 	emailproCmd.AddCommand(editEmailProCmd)
 
+	addArgumentCompletion(emailproCmd, "<service_name>", common.CompleteFromEndpoint("/v1/email/pro", "", false))
+
 	rootCmd.AddCommand(emailproCmd)
 }
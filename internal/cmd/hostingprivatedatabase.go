@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/hostingprivatedatabase"
 	"github.com/spf13/cobra"
 )
@@ -41,7 +42,10 @@ func init() {
 	}
 	hostingprivatedatabaseEditCmd.Flags().StringVar(&hostingprivatedatabase.HostingPrivateDatabaseDisplayName, "display-name", "", "Display name of the HostingPrivateDatabase")
 	addInteractiveEditorFlag(hostingprivatedatabaseEditCmd)
+	addEditFlags(hostingprivatedatabaseEditCmd)
 	hostingprivatedatabaseCmd.AddCommand(hostingprivatedatabaseEditCmd)
 
+	addArgumentCompletion(hostingprivatedatabaseCmd, "<service_name>", common.CompleteFromEndpoint("/v1/hosting/privateDatabase", "", false))
+
 	rootCmd.AddCommand(hostingprivatedatabaseCmd)
 }
@@ -70,6 +70,7 @@ func init() {
 	iamPolicyEditCmd.Flags().StringSliceVar(&iam.IAMPolicySpec.PermissionsGroupsURNs, "permissions-group", nil, "Permissions group URNs")
 	iamPolicyEditCmd.Flags().StringSliceVar(&iam.IAMPolicySpec.ResourcesURNs, "resource", nil, "Resource URNs")
 	addInteractiveEditorFlag(iamPolicyEditCmd)
+	addEditFlags(iamPolicyEditCmd)
 	iamPolicyCmd.AddCommand(iamPolicyEditCmd)
 
 	iamPolicyCmd.AddCommand(&cobra.Command{
@@ -111,6 +112,7 @@ func init() {
 	iamPermissionsGroupEditCmd.Flags().StringSliceVar(&iam.IAMPolicySpec.PermissionsDenied, "deny", nil, "List of denied actions")
 	iamPermissionsGroupEditCmd.Flags().StringSliceVar(&iam.IAMPolicySpec.PermissionsExcept, "except", nil, "List of actions to filter from the allowed list")
 	addInteractiveEditorFlag(iamPermissionsGroupEditCmd)
+	addEditFlags(iamPermissionsGroupEditCmd)
 	iamPermissionsGroupCmd.AddCommand(iamPermissionsGroupEditCmd)
 
 	iamResourceCmd := &cobra.Command{
@@ -141,6 +143,7 @@ func init() {
 	}
 	iamResourceEditCmd.Flags().StringToStringVar(&iam.IAMResourceSpec.Tags, "tag", nil, "Tags to apply to the resource")
 	addInteractiveEditorFlag(iamResourceEditCmd)
+	addEditFlags(iamResourceEditCmd)
 	iamResourceCmd.AddCommand(iamResourceEditCmd)
 
 	iamResourceGroupCmd := &cobra.Command{
@@ -172,6 +175,7 @@ func init() {
 	iamResourceGroupEditCmd.Flags().StringVar(&iam.IAMPolicySpec.Name, "name", "", "Name of the resource group")
 	iamResourceGroupEditCmd.Flags().StringSliceVar(&iam.IAMPolicySpec.ResourcesURNs, "resource", nil, "List of resource URNs to include in the group")
 	addInteractiveEditorFlag(iamResourceGroupEditCmd)
+	addEditFlags(iamResourceGroupEditCmd)
 	iamResourceGroupCmd.AddCommand(iamResourceGroupEditCmd)
 
 	// Users
@@ -360,6 +364,7 @@ There are three ways to define the editing parameters:
 	// Common flags for other means to define parameters
 	addInitParameterFileFlag(userEditCmd, assets.MeOpenapiSchema, "/me/identity/user", "post", iam.UserEditExample, nil)
 	addInteractiveEditorFlag(userEditCmd)
+	addEditFlags(userEditCmd)
 	addFromFileFlag(userEditCmd)
 	userEditCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
 
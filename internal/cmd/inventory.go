@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/ovh/ovhcloud-cli/internal/services/inventory"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	inventoryCmd := &cobra.Command{
+		Use:   "inventory",
+		Short: "Generate inventories of your hosts for configuration management tools",
+	}
+
+	ansibleCmd := &cobra.Command{
+		Use:   "ansible",
+		Short: "Output an Ansible dynamic inventory of your cloud instances, VPS and dedicated servers",
+		Long: `Output an Ansible dynamic inventory (JSON) of the Public Cloud instances of all your projects,
+your VPS and your dedicated servers, with ansible_host set to their public IP and their properties
+available in the "ovhcloud" host variable.
+
+The hosts are grouped by type (cloud_instance, vps and baremetal), and the cloud instances by
+project, region, flavor and image (e.g. project_<id>, region_GRA11, flavor_b3-8, image_Debian_12).
+Additional groups can be defined with --group-by, using https://github.com/PaesslerAG/gval
+expressions evaluated on the properties of each host and returning a group name or a list of
+group names. Hosts for which an expression fails or returns no name are not added to a group.
+
+To use it as an inventory, create an executable script calling the CLI:
+
+	#!/bin/sh
+	exec ovhcloud inventory ansible "$@"`,
+		Example: `ovhcloud inventory ansible --list
+ovhcloud inventory ansible --host my-instance
+ovhcloud inventory ansible --list --group-by '"state_" + state' --filter 'type!="vps"'
+ansible-inventory -i ./ovhcloud-inventory.sh --graph`,
+		Args: cobra.NoArgs,
+		Run:  inventory.AnsibleInventory,
+	}
+	ansibleCmd.Flags().BoolVar(&inventory.AnsibleList, "list", false, "Output the whole inventory")
+	ansibleCmd.Flags().StringVar(&inventory.AnsibleHost, "host", "", "Output the variables of the given host")
+	ansibleCmd.Flags().StringArrayVar(&inventory.AnsibleGroupBy, "group-by", nil, "Expression returning additional groups of each host (can be repeated)")
+	ansibleCmd.MarkFlagsMutuallyExclusive("list", "host")
+	ansibleCmd.MarkFlagsOneRequired("list", "host")
+	inventoryCmd.AddCommand(withFilterFlag(ansibleCmd))
+
+	rootCmd.AddCommand(inventoryCmd)
+}
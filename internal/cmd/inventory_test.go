@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+)
+
+func (ms *MockSuite) TestInventoryAnsibleListCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project",
+		httpmock.NewStringResponder(200, `["fakeProjectID"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance",
+		httpmock.NewStringResponder(200, `[{"id": "instance-1", "name": "web-1"}]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/instance-1",
+		httpmock.NewStringResponder(200, `{
+			"id": "instance-1",
+			"name": "web-1",
+			"region": "GRA11",
+			"status": "ACTIVE",
+			"flavor": {"id": "flavor-1", "name": "b3-8"},
+			"image": {"id": "image-1", "name": "Debian 12"},
+			"ipAddresses": [
+				{"ip": "10.0.0.12", "type": "private", "version": 4},
+				{"ip": "2001:db8::12", "type": "public", "version": 6},
+				{"ip": "51.68.0.12", "type": "public", "version": 4}
+			]
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-12345.vps.ovh.net"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps/vps-12345.vps.ovh.net",
+		httpmock.NewStringResponder(200, `{"name": "vps-12345.vps.ovh.net", "state": "running", "zone": "Region OpenStack: os-gra11"}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps/vps-12345.vps.ovh.net/ips",
+		httpmock.NewStringResponder(200, `["2001:db8::34", "51.68.0.34"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/dedicated/server",
+		httpmock.NewStringResponder(200, `["ns1234.ip-51-68-0.eu"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/dedicated/server/ns1234.ip-51-68-0.eu",
+		httpmock.NewStringResponder(200, `{"name": "ns1234.ip-51-68-0.eu", "ip": "51.68.0.56", "datacenter": "gra3", "state": "ok"}`))
+
+	out, err := cmd.Execute("inventory", "ansible", "--list", "--group-by", `"dc_" + datacenter`)
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"_meta": {
+			"hostvars": {
+				"web-1": {
+					"ansible_host": "51.68.0.12",
+					"ovhcloud": SuperMapOf({"type": "cloud_instance", "projectId": "fakeProjectID", "region": "GRA11"})
+				},
+				"vps-12345.vps.ovh.net": {
+					"ansible_host": "51.68.0.34",
+					"ovhcloud": SuperMapOf({"type": "vps", "state": "running"})
+				},
+				"ns1234.ip-51-68-0.eu": {
+					"ansible_host": "51.68.0.56",
+					"ovhcloud": SuperMapOf({"type": "baremetal", "datacenter": "gra3"})
+				}
+			}
+		},
+		"all": {
+			"children": [
+				"baremetal",
+				"cloud_instance",
+				"dc_gra3",
+				"flavor_b3_8",
+				"image_Debian_12",
+				"project_fakeProjectID",
+				"region_GRA11",
+				"vps"
+			]
+		},
+		"baremetal": {"hosts": ["ns1234.ip-51-68-0.eu"]},
+		"cloud_instance": {"hosts": ["web-1"]},
+		"dc_gra3": {"hosts": ["ns1234.ip-51-68-0.eu"]},
+		"flavor_b3_8": {"hosts": ["web-1"]},
+		"image_Debian_12": {"hosts": ["web-1"]},
+		"project_fakeProjectID": {"hosts": ["web-1"]},
+		"region_GRA11": {"hosts": ["web-1"]},
+		"vps": {"hosts": ["vps-12345.vps.ovh.net"]}
+	}`))
+}
+
+func (ms *MockSuite) TestInventoryAnsibleHostCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project",
+		httpmock.NewStringResponder(200, `[]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-12345.vps.ovh.net"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps/vps-12345.vps.ovh.net",
+		httpmock.NewStringResponder(200, `{"name": "vps-12345.vps.ovh.net", "state": "running", "zone": "Region OpenStack: os-gra11"}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/vps/vps-12345.vps.ovh.net/ips",
+		httpmock.NewStringResponder(200, `["2001:db8::34", "51.68.0.34"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/dedicated/server",
+		httpmock.NewStringResponder(200, `[]`))
+
+	out, err := cmd.Execute("inventory", "ansible", "--host", "vps-12345.vps.ovh.net")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"ansible_host": "51.68.0.34",
+		"ovhcloud": {
+			"name": "vps-12345.vps.ovh.net",
+			"state": "running",
+			"type": "vps",
+			"zone": "Region OpenStack: os-gra11"
+		}
+	}`))
+	cmd.PostExecute()
+
+	out, err = cmd.Execute("inventory", "ansible", "--host", "unknown")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{}`))
+}
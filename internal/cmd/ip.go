@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/ip"
 	"github.com/spf13/cobra"
 )
@@ -41,6 +42,7 @@ func init() {
 	}
 	ipEditCmd.Flags().StringVar(&ip.IPSpec.Description, "description", "", "Description of the IP")
 	addInteractiveEditorFlag(ipEditCmd)
+	addEditFlags(ipEditCmd)
 	ipCmd.AddCommand(ipEditCmd)
 
 	ipReverseCmd := &cobra.Command{
@@ -73,5 +75,7 @@ func init() {
 	}
 	ipReverseCmd.AddCommand(ipReverseDeleteCmd)
 
+	addArgumentCompletion(ipCmd, "<service_name>", common.CompleteFromEndpoint("/v1/ip", "", false))
+
 	rootCmd.AddCommand(ipCmd)
 }
@@ -7,6 +7,7 @@ package cmd
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/iploadbalancing"
 	"github.com/spf13/cobra"
 )
@@ -44,7 +45,10 @@ func init() {
 	iploadbalancingEditCmd.Flags().StringVar(&iploadbalancing.IPLoadbalancingSpec.DisplayName, "display-name", "", "Display name of the load balancer")
 	iploadbalancingEditCmd.Flags().StringVar(&iploadbalancing.IPLoadbalancingSpec.SSLConfiguration, "ssl-configuration", "", "SSL configuration of the load balancer (intermediate, modern)")
 	addInteractiveEditorFlag(iploadbalancingEditCmd)
+	addEditFlags(iploadbalancingEditCmd)
 	iploadbalancingCmd.AddCommand(iploadbalancingEditCmd)
 
+	addArgumentCompletion(iploadbalancingCmd, "<service_name>", common.CompleteFromEndpoint("/v1/ipLoadbalancing", "", false))
+
 	rootCmd.AddCommand(iploadbalancingCmd)
 }
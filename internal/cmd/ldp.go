@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/ldp"
 	"github.com/spf13/cobra"
 )
@@ -42,7 +43,10 @@ func init() {
 	ldpEditCmd.Flags().StringVar(&ldp.LdpSpec.DisplayName, "display-name", "", "Display name of the LDP")
 	ldpEditCmd.Flags().BoolVar(&ldp.LdpSpec.EnableIAM, "enable-iam", false, "Enable IAM for the LDP")
 	addInteractiveEditorFlag(ldpEditCmd)
+	addEditFlags(ldpEditCmd)
 	ldpCmd.AddCommand(ldpEditCmd)
 
+	addArgumentCompletion(ldpCmd, "<service_name>", common.CompleteFromEndpoint("/v1/dbaas/logs", "", false))
+
 	rootCmd.AddCommand(ldpCmd)
 }
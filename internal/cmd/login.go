@@ -19,5 +19,7 @@ func init() {
 	// Disable parent pre-run that verifies if the API client is correctly initialized
 	loginCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {}
 
+	addConfigScopeFlag(loginCmd)
+
 	rootCmd.AddCommand(loginCmd)
 }
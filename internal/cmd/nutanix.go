@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/nutanix"
 	"github.com/spf13/cobra"
 )
@@ -32,5 +33,7 @@ func init() {
 		Run:   nutanix.GetNutanix,
 	})
 
+	addArgumentCompletion(nutanixCmd, "<service_name>", common.CompleteFromEndpoint("/v1/nutanix", "", false))
+
 	rootCmd.AddCommand(nutanixCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/okms"
 	"github.com/spf13/cobra"
 )
@@ -32,5 +33,7 @@ func init() {
 		Run:   okms.GetOkms,
 	})
 
+	addArgumentCompletion(okmsCmd, "<service_name>", common.CompleteFromEndpoint("/v2/okms/resource", "id", false))
+
 	rootCmd.AddCommand(okmsCmd)
 }
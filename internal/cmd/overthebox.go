@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/overthebox"
 	"github.com/spf13/cobra"
 )
@@ -43,7 +44,10 @@ func init() {
 	overtheboxEditCmd.Flags().StringVar(&overthebox.OverTheBoxSpec.CustomerDescription, "customer-description", "", "Customer description")
 	overtheboxEditCmd.Flags().StringVar(&overthebox.OverTheBoxSpec.ReleaseChannel, "release-channel", "", "Release channel")
 	addInteractiveEditorFlag(overtheboxEditCmd)
+	addEditFlags(overtheboxEditCmd)
 	overtheboxCmd.AddCommand(overtheboxEditCmd)
 
+	addArgumentCompletion(overtheboxCmd, "<service_name>", common.CompleteFromEndpoint("/v1/overTheBox", "", false))
+
 	rootCmd.AddCommand(overtheboxCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/ovhcloudconnect"
 	"github.com/spf13/cobra"
 )
@@ -41,7 +42,10 @@ func init() {
 	}
 	ovhcloudconnectEditCmd.Flags().StringVar(&ovhcloudconnect.OvhCloudConnectSpec.Description, "description", "", "Description")
 	addInteractiveEditorFlag(ovhcloudconnectEditCmd)
+	addEditFlags(ovhcloudconnectEditCmd)
 	ovhcloudconnectCmd.AddCommand(ovhcloudconnectEditCmd)
 
+	addArgumentCompletion(ovhcloudconnectCmd, "<service_name>", common.CompleteFromEndpoint("/v1/ovhCloudConnect", "", false))
+
 	rootCmd.AddCommand(ovhcloudconnectCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/packxdsl"
 	"github.com/spf13/cobra"
 )
@@ -41,7 +42,10 @@ func init() {
 	}
 	packxdslEditCmd.Flags().StringVar(&packxdsl.PackXDSLSpec.Description, "description", "", "Description of the PackXDSL")
 	addInteractiveEditorFlag(packxdslEditCmd)
+	addEditFlags(packxdslEditCmd)
 	packxdslCmd.AddCommand(packxdslEditCmd)
 
+	addArgumentCompletion(packxdslCmd, "<service_name>", common.CompleteFromEndpoint("/v1/pack/xdsl", "", false))
+
 	rootCmd.AddCommand(packxdslCmd)
 }
@@ -189,7 +189,7 @@ func addInitParameterFileFlag(cmd *cobra.Command, openapiSchema []byte, path, me
 		}
 
 		display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Parameter file written at %s", paramFile)
-		os.Exit(0)
+		display.Exit(0)
 	}
 }
 
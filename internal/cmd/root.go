@@ -5,13 +5,18 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
 	"runtime"
+	"strings"
+	"sync"
 	"sync/atomic"
+	"syscall"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -39,12 +44,31 @@ var (
 		"replace",
 		"output",
 		"debug",
+		"profile",
+		"record",
+		"replay",
 	}
 
 	wasmHiddenCommands = []string{
 		"login",
 		"config",
+		"templates",
+		"dev",
+		"shell",
+		"batch",
 	}
+
+	// configurableFlags are the global flags whose default value can be defined
+	// in the configuration, associated to the corresponding configuration key
+	configurableFlags = map[string]string{
+		"max-retries": "max_retries",
+		"parallel":    "parallel",
+		"rate-limit":  "rate_limit",
+	}
+
+	// executeDepth is the number of nested executions, greater than one for
+	// the commands run by the shell or a batch
+	executeDepth int
 )
 
 func GetRootCommand() *cobra.Command {
@@ -54,11 +78,33 @@ func GetRootCommand() *cobra.Command {
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute(args ...string) (string, error) {
+	executeDepth++
+	defer func() {
+		executeDepth--
+	}()
+
 	if len(args) != 0 {
 		rootCmd.SetArgs(args)
 	}
 
-	err := rootCmd.Execute()
+	// Cancel the running command on the first interruption, in-flight API calls
+	// and waits are then stopped and partial results displayed. The signals are
+	// no longer caught once the context is cancelled, so that a second Ctrl-C
+	// terminates the CLI immediately.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	stopInterruption := context.AfterFunc(ctx, func() {
+		display.Interrupted.Store(true)
+		stop()
+	})
+	defer stopInterruption()
+
+	// Cobra only gives the context to the subcommands that do not have one yet,
+	// set it explicitly to not keep the context of a previous execution
+	setSubCommandContext(rootCmd, ctx)
+
+	err := rootCmd.ExecuteContext(ctx)
 	if err != nil {
 		return display.ResultString, err
 	}
@@ -66,32 +112,117 @@ func Execute(args ...string) (string, error) {
 	return display.ResultString, display.ResultError
 }
 
+// exitCode is the panic value used to stop a command instead of exiting, see ExecuteNoExit
+type exitCode int
+
+// ExecuteNoExit runs the given command line like Execute, but returns the exit code of the
+// commands terminating the CLI instead of exiting. It is used to run several commands in
+// the same process, reusing the API client.
+func ExecuteNoExit(args ...string) (out string, code int, err error) {
+	previousExit := display.Exit
+	display.Exit = func(code int) {
+		panic(exitCode(code))
+	}
+
+	defer func() {
+		display.Exit = previousExit
+
+		if r := recover(); r != nil {
+			c, ok := r.(exitCode)
+			if !ok {
+				panic(r)
+			}
+			out, code, err = display.ResultString, int(c), display.ResultError
+		}
+	}()
+
+	out, err = Execute(args...)
+	if err != nil {
+		code = 1
+	}
+
+	return out, code, err
+}
+
 func PostExecute() {
 	// Reset output variables
 	display.ResultString = ""
 	display.ResultError = nil
+	display.Interrupted.Store(false)
 
 	// Reset all flags to default values
 	flags.GenericFilters = nil
 	flags.OutputFormatConfig = display.OutputFormat{}
 	flags.ParametersViaEditor = false
 	flags.ParametersFile = ""
+	flags.Profile = ""
+	flags.ConfigScope = ""
+	flags.DryRun = false
+	flags.SkipValidation = false
+	flags.RecordFile = ""
+	flags.ReplayFile = ""
+
+	// The cassette is used until the end of the command running other commands (shell, batch)
+	if executeDepth == 0 {
+		httplib.StopCassette()
+	}
+	for name := range configurableFlags {
+		flag := rootCmd.PersistentFlags().Lookup(name)
+		flag.Value.Set(flag.DefValue)
+		flag.Changed = false
+	}
 
 	// Recursively reset all flags of all subcommands to their default values
 	resetSubCommandFlagValues(rootCmd)
 }
 
+// nestedFlags are the global flags given to a command running other commands (shell, batch),
+// applied to each of the commands it runs since the flags are reset after each of them
+type nestedFlags struct {
+	profile        string
+	dryRun         bool
+	skipValidation bool
+	recordFile     string
+	replayFile     string
+}
+
+func getNestedFlags() nestedFlags {
+	return nestedFlags{
+		profile:        flags.Profile,
+		dryRun:         flags.DryRun,
+		skipValidation: flags.SkipValidation,
+		recordFile:     flags.RecordFile,
+		replayFile:     flags.ReplayFile,
+	}
+}
+
+// apply sets the global flags before running a command, they can still be
+// overridden by the flags given to the command
+func (f nestedFlags) apply() {
+	flags.Profile = f.profile
+	flags.DryRun = f.dryRun
+	flags.SkipValidation = f.skipValidation
+	flags.RecordFile = f.recordFile
+	flags.ReplayFile = f.replayFile
+}
+
+// setSubCommandContext sets the given context to all subcommands of the given root command.
+func setSubCommandContext(root *cobra.Command, ctx context.Context) {
+	for _, c := range root.Commands() {
+		c.SetContext(ctx)
+		setSubCommandContext(c, ctx)
+	}
+}
+
 // resetSubCommandFlagValues resets all flags of all subcommands of the given root command to their default values.
 func resetSubCommandFlagValues(root *cobra.Command) {
 	for _, c := range root.Commands() {
 		c.Flags().VisitAll(func(f *pflag.Flag) {
 			if f.Changed {
-				if f.Value.Type() == "stringArray" {
-					// Special handling for stringArray for which we cannot
+				if r, ok := f.Value.(pflag.SliceValue); ok {
+					// Special handling for slices for which we cannot
 					// use DefValue since it is equal to "[]".
-					if r, ok := f.Value.(pflag.SliceValue); ok {
-						r.Replace(nil)
-					}
+					r.Replace(nil)
 				} else {
 					f.Value.Set(f.DefValue)
 				}
@@ -107,75 +238,156 @@ func initRootCmd() {
 
 	rootCmd.PersistentFlags().BoolVarP(&flags.Debug, "debug", "d", false, "Activate debug mode (will log all HTTP requests details)")
 	rootCmd.PersistentFlags().BoolVarP(&flags.IgnoreErrors, "ignore-errors", "e", false, "Ignore errors in API calls when it is not fatal to the execution")
-	rootCmd.PersistentFlags().StringVarP(&flags.OutputFormatConfig.Output, "output", "o", "", `Output format: json, yaml, interactive, or a custom format expression (using https://github.com/PaesslerAG/gval syntax)
+	rootCmd.PersistentFlags().BoolVar(&flags.DryRun, "dry-run", false, "Print the API calls modifying resources (POST, PUT, PATCH, DELETE) instead of sending them, read-only calls are still sent")
+	rootCmd.PersistentFlags().BoolVar(&flags.SkipValidation, "skip-validation", false, "Send the request bodies without validating them against the API schemas")
+	rootCmd.PersistentFlags().StringVar(&flags.RecordFile, "record", "", "Record the API calls and their responses in the given cassette file, with credentials and secrets redacted")
+	rootCmd.PersistentFlags().StringVar(&flags.ReplayFile, "replay", "", "Serve the API responses recorded in the given cassette file instead of calling the API")
+	rootCmd.MarkFlagsMutuallyExclusive("record", "replay")
+	rootCmd.PersistentFlags().StringVarP(&flags.OutputFormatConfig.Output, "output", "o", "", `Output format: json, yaml, ndjson, interactive, csv, tsv, template=<template>, template-file=<path>, or a custom format expression (using https://github.com/PaesslerAG/gval syntax)
 Examples:
   --output json
   --output yaml
+  --output ndjson (one compact JSON object per line, as soon as it is fetched)
   --output interactive
+  --output csv (to export lists, see --columns)
+  --output 'template={{ range .Result }}{{ .name }}{{ "\n" }}{{ end }}' (to render a Go template, with .Result and .ServiceName)
+  --output template-file=./output.tmpl (to render a Go template read from a file)
   --output 'id' (to extract a single field)
   --output 'nested.field.subfield' (to extract a nested field)
   --output '[id, "name"]' (to extract multiple fields as an array)
   --output '{"newKey": oldKey, "otherKey": nested.field}' (to extract and rename fields in an object)
   --output 'name+","+type' (to extract and concatenate fields in a string)
   --output '(nbFieldA + nbFieldB) * 10' (to compute values from numeric fields)`)
+	rootCmd.PersistentFlags().StringSliceVar(&flags.OutputFormatConfig.Columns, "columns", nil, `Columns of the lists to display, as gval selectors optionally followed by an alias (e.g. --columns 'id,name,flavor.name flavor'). Default columns can be defined per command in the [ovh-cli columns] configuration section`)
+	rootCmd.PersistentFlags().StringVar(&flags.OutputFormatConfig.SortBy, "sort-by", "", `Sort the items of lists using the given gval expression (e.g. --sort-by 'name' or --sort-by 'flavor.vcpus')`)
+	rootCmd.PersistentFlags().BoolVar(&flags.OutputFormatConfig.Desc, "desc", false, "Sort the items of lists in descending order")
+	rootCmd.PersistentFlags().IntVar(&flags.OutputFormatConfig.Limit, "limit", 0, "Maximum number of items of lists to display, 0 for no limit")
+	rootCmd.PersistentFlags().StringVar(&flags.Profile, "profile", "", "Configuration profile to use (can also be set using OVH_PROFILE environment variable)")
+	rootCmd.PersistentFlags().IntVar(&flags.MaxRetries, "max-retries", httplib.DefaultMaxRetries, "Maximum number of retries of idempotent API calls failing with a transient error, up to 10 (can also be set using max_retries configuration key)")
+	rootCmd.PersistentFlags().IntVar(&flags.Parallel, "parallel", httplib.DefaultParallelRequests, "Number of concurrent API calls made to fetch the items of a list (can also be set using parallel configuration key)")
+	rootCmd.PersistentFlags().Float64Var(&flags.RateLimit, "rate-limit", httplib.DefaultRateLimit, "Maximum number of API calls per second, 0 for no limit (can also be set using rate_limit configuration key)")
+
+	// Re-initialize the API client if the profile given by flag differs from the one used at startup
+	cobra.OnInitialize(func() {
+		if profile := config.ResolveProfile(flags.CliConfig, flags.Profile); profile != httplib.ClientProfile {
+			config.ActiveProfile = profile
+			httplib.InitClient()
+		}
+
+		// Record or replay the API calls using the given cassette file
+		switch {
+		case flags.RecordFile != "":
+			if err := httplib.StartRecording(flags.RecordFile); err != nil {
+				display.OutputError(&flags.OutputFormatConfig, "failed to record API calls: %s", err)
+			}
+		case flags.ReplayFile != "":
+			if err := httplib.StartReplaying(flags.ReplayFile); err != nil {
+				display.OutputError(&flags.OutputFormatConfig, "failed to replay API calls: %s", err)
+			}
+		}
+
+		// Use the templates of the user templates directory instead of the embedded ones
+		if dir, err := config.ExpandTemplatesDir(); err == nil {
+			if err := display.LoadTemplateOverrides(dir); err != nil {
+				log.Printf("failed to load user templates: %s", err)
+			}
+		}
 
-	var newVersionMessage atomic.Pointer[string]
+		// Use the values defined in the configuration for the flags not given in the command line
+		for name, key := range configurableFlags {
+			flag := rootCmd.PersistentFlags().Lookup(name)
+			if flag.Changed {
+				continue
+			}
+			if value, _ := config.GetConfigValue(flags.CliConfig, "", key); value != "" {
+				if err := flag.Value.Set(value); err != nil {
+					log.Printf("invalid value %q for %s in configuration: %s", value, key, err)
+				}
+			}
+		}
+
+		if flags.MaxRetries < 0 || flags.MaxRetries > httplib.MaxRetriesLimit {
+			display.OutputError(&flags.OutputFormatConfig, "invalid number of retries %d, it must be between 0 and %d", flags.MaxRetries, httplib.MaxRetriesLimit)
+		}
+	})
+
+	var (
+		newVersionMessage atomic.Pointer[string]
+		versionCheck      sync.Once
+	)
 	rootCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
-		// Check if a new version is available in a separate goroutine
+		// Check if a new version is available in a separate goroutine, only once
+		// when several commands are run in the same process (shell, batch).
 		// Don't do it when running in WASM binary
 		if !(runtime.GOARCH == "wasm" && runtime.GOOS == "js") {
-			go func() {
-				// Skip version check if version is undefined (development mode)
-				if version.Version == "undefined" {
-					return
-				}
+			versionCheck.Do(func() {
+				go func() {
+					// Skip version check if version is undefined (development mode)
+					if version.Version == "undefined" {
+						return
+					}
 
-				const latestURL = "https://github.com/ovh/ovhcloud-cli/releases/latest"
-				req, err := http.NewRequest("GET", latestURL, nil)
-				if err != nil {
-					return
-				}
-				req.Header.Set("Accept", "application/json")
-				resp, err := http.DefaultClient.Do(req)
-				if err != nil {
-					return
-				}
-				defer resp.Body.Close()
-				var data struct {
-					TagName string `json:"tag_name"`
-				}
-				if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-					return
-				}
-				if data.TagName != "" && data.TagName != version.Version {
-					message := fmt.Sprintf("A new version of ovhcloud-cli is available: %s (current: %s)", data.TagName, version.Version)
-					newVersionMessage.Store(&message)
-				}
-			}()
+					const latestURL = "https://github.com/ovh/ovhcloud-cli/releases/latest"
+					req, err := http.NewRequest("GET", latestURL, nil)
+					if err != nil {
+						return
+					}
+					req.Header.Set("Accept", "application/json")
+					resp, err := http.DefaultClient.Do(req)
+					if err != nil {
+						return
+					}
+					defer resp.Body.Close()
+					var data struct {
+						TagName string `json:"tag_name"`
+					}
+					if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+						return
+					}
+					if data.TagName != "" && data.TagName != version.Version {
+						message := fmt.Sprintf("A new version of ovhcloud-cli is available: %s (current: %s)", data.TagName, version.Version)
+						newVersionMessage.Store(&message)
+					}
+				}()
+			})
+		}
+
+		// Use the default columns defined in the configuration for this command
+		commandPath := strings.TrimPrefix(cmd.CommandPath(), rootCmd.Name()+" ")
+		if columns, err := config.GetDefaultColumns(flags.CliConfig, commandPath); err != nil {
+			log.Print(err)
+		} else {
+			flags.OutputFormatConfig.DefaultColumns = columns
+		}
+
+		// No task is started in dry-run mode, so there is nothing to wait for
+		if flags.DryRun {
+			flags.WaitForTask = false
 		}
 
 		// Check if the API client is initialized
 		if httplib.Client == nil {
 			display.OutputError(&flags.OutputFormatConfig, "API client is not initialized, please run `ovhcloud login` to authenticate")
-			os.Exit(1) // Force os.Exit even in WASM mode
+			display.Exit(1) // Force exit even in WASM mode
 		}
 	}
 
 	// Set PostRun to display the new version message if available
 	rootCmd.PersistentPostRun = func(cmd *cobra.Command, args []string) {
-		if msg := newVersionMessage.Load(); msg != nil {
+		if msg := newVersionMessage.Swap(nil); msg != nil {
 			log.Println(*msg)
 		}
 	}
 }
 
 func init() {
-	httplib.InitClient()
-
 	// Load configuration files by order of increasing priority. All configuration
 	// files are optional. Only load file from user home if home could be resolve
 	flags.CliConfig, flags.CliConfigPath = config.LoadINI()
 
+	config.ActiveProfile = config.ResolveProfile(flags.CliConfig, "")
+	httplib.InitClient()
+
 	initRootCmd()
 }
 
@@ -235,3 +447,43 @@ Examples:
 
 	return c
 }
+
+// addWaitFlags adds the flags used to wait for the end of the task started by the given command
+func addWaitFlags(c *cobra.Command, usage string) {
+	c.Flags().BoolVar(&flags.WaitForTask, "wait", false, usage)
+	c.Flags().DurationVar(&flags.WaitTimeout, "wait-timeout", 0, "Maximum duration to wait for (e.g. 30m, 1h), defaults to a value depending on the task")
+	c.Flags().DurationVar(&flags.PollInterval, "poll-interval", 0, "Initial interval between two status checks while waiting (e.g. 10s), increased after each check")
+}
+
+// addEditFlags adds the flags used to review the changes of the given edit command
+func addEditFlags(c *cobra.Command) {
+	c.Flags().BoolVar(&flags.ShowDiff, "diff", false, "Display the changes before applying them, even when no terminal is attached")
+	c.Flags().BoolVarP(&flags.AssumeYes, "yes", "y", false, "Apply the changes without asking for confirmation")
+}
+
+// addArgumentCompletion sets the given completion function on the given command and all
+// its subcommands whose first argument is the given one (e.g. "<service_name>")
+func addArgumentCompletion(c *cobra.Command, argument string, complete cobra.CompletionFunc) {
+	if fields := strings.Fields(c.Use); len(fields) > 1 && fields[1] == argument && c.ValidArgsFunction == nil {
+		c.ValidArgsFunction = complete
+	}
+
+	for _, child := range c.Commands() {
+		addArgumentCompletion(child, argument, complete)
+	}
+}
+
+// addWaitConditionFlags adds the flags of the commands waiting for a resource to match a condition
+func addWaitConditionFlags(c *cobra.Command, defaultFailure string) {
+	c.Flags().StringVar(&flags.WaitCondition, "for", "", "Condition to wait for, as a gval expression evaluated on the resource")
+	c.MarkFlagRequired("for")
+
+	failureUsage := "Condition stopping the wait in error, as a gval expression evaluated on the resource"
+	if defaultFailure != "" {
+		failureUsage += fmt.Sprintf(" (defaults to '%s')", defaultFailure)
+	}
+	c.Flags().StringVar(&flags.WaitFailureCondition, "failure", "", failureUsage)
+
+	c.Flags().DurationVar(&flags.WaitTimeout, "timeout", 0, "Maximum duration to wait for (e.g. 30m, 1h), defaults to a value depending on the resource")
+	c.Flags().DurationVar(&flags.PollInterval, "poll-interval", 0, "Initial interval between two status checks while waiting (e.g. 10s), increased after each check")
+}
@@ -0,0 +1,387 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	shellwords "github.com/mattn/go-shellwords"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/ovh/ovhcloud-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// maxShellHistory is the number of lines kept in the history of the shell
+const maxShellHistory = 1000
+
+// shellBuiltins are the commands handled by the shell itself
+var shellBuiltins = []string{"use", "exit", "quit"}
+
+// shellContext is the context applied to all the commands run in the shell
+type shellContext struct {
+	project string
+	region  string
+
+	// Cloud project defined in the environment when the shell was started,
+	// restored when the project of the context is cleared
+	envProject    string
+	hasEnvProject bool
+
+	// Global flags given to the shell, applied to all the commands
+	globalFlags nestedFlags
+}
+
+func newShellContext() *shellContext {
+	envProject, hasEnvProject := os.LookupEnv("OVH_CLOUD_PROJECT_SERVICE")
+	return &shellContext{
+		envProject:    envProject,
+		hasEnvProject: hasEnvProject,
+		globalFlags:   getNestedFlags(),
+	}
+}
+
+func init() {
+	shellCmd := &cobra.Command{
+		Use:   "shell",
+		Short: "Start an interactive shell to run several commands",
+		Long: `Start an interactive shell running the CLI commands typed at the prompt, without the "ovhcloud" prefix.
+
+The API client is initialized once and reused by all the commands. The shell provides a history
+of the commands (browsed using the up and down keys) and their completion (using the tab key).
+
+The following commands define a context applied to the next commands:
+
+	use project <project_id>   Use the given cloud project when no --cloud-project flag is given
+	use region <region>        Use the given region for the commands having a --region flag
+	use project|region         Clear the cloud project or region of the context
+	use                        Display the current context
+
+Type "exit", "quit" or Ctrl-D to leave the shell.`,
+		Example: `ovhcloud shell
+ovhcloud> use project xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx
+ovhcloud (project xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx)> cloud instance list`,
+		Args: cobra.NoArgs,
+		Run:  runShell,
+	}
+
+	// The client is checked by each of the commands run in the shell
+	shellCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {}
+
+	rootCmd.AddCommand(shellCmd)
+}
+
+func runShell(_ *cobra.Command, _ []string) {
+	var (
+		sticky      = newShellContext()
+		historyPath = shellHistoryPath()
+		history     = loadShellHistory(historyPath)
+		interactive = utils.IsInteractiveTerminal()
+		scanner     = bufio.NewScanner(os.Stdin)
+	)
+
+	// Commands are read from the standard input, it cannot be used to give parameters
+	utils.StdinReserved = true
+	defer func() {
+		utils.StdinReserved = false
+	}()
+	defer sticky.restoreEnvProject()
+
+	for {
+		var (
+			line string
+			err  error
+		)
+
+		if interactive {
+			line, err = display.RunPrompt(sticky.prompt(), history, sticky.completions)
+		} else if scanner.Scan() {
+			line = scanner.Text()
+		} else {
+			err = scanner.Err()
+			if err == nil {
+				err = io.EOF
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			fmt.Printf("🛑 failed to read command: %s\n", err)
+			return
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if interactive && (len(history) == 0 || history[len(history)-1] != line) {
+			history = append(history, line)
+			appendShellHistory(historyPath, line)
+		}
+
+		if !sticky.run(line) {
+			return
+		}
+	}
+}
+
+// run runs the given command line, and returns false when the shell must be left
+func (c *shellContext) run(line string) bool {
+	args, err := shellwords.Parse(line)
+	if err != nil {
+		fmt.Printf("🛑 failed to parse command: %s\n", err)
+		return true
+	}
+
+	// Allow to paste commands including the name of the CLI
+	if len(args) > 0 && args[0] == rootCmd.Name() {
+		args = args[1:]
+	}
+	if len(args) == 0 {
+		return true
+	}
+
+	switch args[0] {
+	case "exit", "quit":
+		return false
+	case "use":
+		c.use(args[1:])
+		return true
+	case "shell":
+		fmt.Println("🟠 Already running in a shell")
+		return true
+	}
+
+	c.globalFlags.apply()
+	ExecuteNoExit(c.apply(args)...)
+	PostExecute()
+
+	return true
+}
+
+// use updates the context using the given arguments of the "use" command
+func (c *shellContext) use(args []string) {
+	if len(args) == 0 {
+		fmt.Printf("Cloud project: %s\nRegion: %s\n", valueOrNone(c.project), valueOrNone(c.region))
+		return
+	}
+
+	var value string
+	if len(args) > 1 {
+		value = args[1]
+	}
+
+	switch args[0] {
+	case "project":
+		c.project = value
+		if value == "" {
+			c.restoreEnvProject()
+		} else {
+			// Used by the cloud commands when no --cloud-project flag is given
+			os.Setenv("OVH_CLOUD_PROJECT_SERVICE", value)
+		}
+	case "region":
+		c.region = value
+	default:
+		fmt.Printf("🛑 unknown context %q, use one of: project, region\n", args[0])
+		return
+	}
+
+	if value == "" {
+		fmt.Printf("✅ No %s used anymore\n", args[0])
+	} else {
+		fmt.Printf("✅ Using %s %s\n", args[0], value)
+	}
+}
+
+// restoreEnvProject restores the cloud project defined in the environment
+// when the shell was started
+func (c *shellContext) restoreEnvProject() {
+	if c.hasEnvProject {
+		os.Setenv("OVH_CLOUD_PROJECT_SERVICE", c.envProject)
+	} else {
+		os.Unsetenv("OVH_CLOUD_PROJECT_SERVICE")
+	}
+}
+
+// apply adds the region of the context to the arguments of commands having a
+// --region flag that is not already given
+func (c *shellContext) apply(args []string) []string {
+	if c.region == "" {
+		return args
+	}
+
+	command, _, err := rootCmd.Find(args)
+	if err != nil {
+		return args
+	}
+
+	regionFlag := command.Flags().Lookup("region")
+	if regionFlag == nil {
+		return args
+	}
+
+	for _, arg := range args {
+		if arg == "--region" || strings.HasPrefix(arg, "--region=") ||
+			(regionFlag.Shorthand != "" && strings.HasPrefix(arg, "-"+regionFlag.Shorthand)) {
+			return args
+		}
+	}
+
+	return append(slices.Clone(args), "--region", c.region)
+}
+
+func (c *shellContext) prompt() string {
+	var details []string
+	if c.project != "" {
+		details = append(details, "project "+c.project)
+	}
+	if c.region != "" {
+		details = append(details, "region "+c.region)
+	}
+
+	if len(details) == 0 {
+		return rootCmd.Name() + "> "
+	}
+
+	return fmt.Sprintf("%s (%s)> ", rootCmd.Name(), strings.Join(details, ", "))
+}
+
+// completions returns the candidates completing the last word of the given line,
+// using the shell completion of the commands
+func (c *shellContext) completions(line string) []string {
+	args, err := shellwords.Parse(line)
+	if err != nil {
+		return nil
+	}
+
+	toComplete := ""
+	if len(args) > 0 && !strings.HasSuffix(line, " ") {
+		toComplete = args[len(args)-1]
+		args = args[:len(args)-1]
+	}
+
+	var candidates []string
+	switch {
+	case len(args) == 0:
+		candidates = append(c.commandCompletions(nil, toComplete), shellBuiltins...)
+	case args[0] == "use" && len(args) == 1:
+		candidates = []string{"project", "region"}
+	case args[0] == "use" && len(args) == 2 && args[1] == "project":
+		candidates, _ = common.CompleteFromEndpoint("/v1/cloud/project", "", false)(rootCmd, nil, toComplete)
+	case args[0] == "use" && len(args) == 2 && args[1] == "region":
+		candidates, _ = cloud.CompleteRegion(rootCmd, nil, toComplete)
+	case slices.Contains(shellBuiltins, args[0]):
+		return nil
+	default:
+		candidates = c.commandCompletions(args, toComplete)
+	}
+
+	var matching []string
+	for _, candidate := range candidates {
+		// Remove the description of the candidate
+		candidate, _, _ = strings.Cut(candidate, "\t")
+		if strings.HasPrefix(candidate, toComplete) {
+			matching = append(matching, candidate)
+		}
+	}
+	slices.Sort(matching)
+
+	return slices.Compact(matching)
+}
+
+// commandCompletions returns the completions of the given command line, as
+// returned by the hidden completion command used by shell completion scripts
+func (c *shellContext) commandCompletions(args []string, toComplete string) []string {
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetErr(io.Discard)
+	defer func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+	}()
+
+	completionArgs := append([]string{cobra.ShellCompRequestCmd}, args...)
+	c.globalFlags.apply()
+	ExecuteNoExit(append(completionArgs, toComplete)...)
+	PostExecute()
+
+	var candidates []string
+	for _, candidate := range strings.Split(out.String(), "\n") {
+		// The last line is the completion directive
+		if strings.HasPrefix(candidate, ":") {
+			break
+		}
+		if candidate != "" {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates
+}
+
+func shellHistoryPath() string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(cacheDir, "ovhcloud", "shell_history")
+}
+
+func loadShellHistory(path string) []string {
+	if path == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	history := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(history) > maxShellHistory {
+		history = history[len(history)-maxShellHistory:]
+	}
+
+	return slices.DeleteFunc(history, func(line string) bool { return line == "" })
+}
+
+func appendShellHistory(path, line string) {
+	if path == "" {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+
+	fmt.Fprintln(file, line)
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "none"
+	}
+	return value
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/go-ovh/ovh"
+	httplib "github.com/ovh/ovhcloud-cli/internal/http"
+)
+
+func TestShellUseProject(t *testing.T) {
+	t.Setenv("OVH_CLOUD_PROJECT_SERVICE", "envProject")
+
+	c := newShellContext()
+
+	c.use([]string{"project", "fakeProjectID"})
+	td.Cmp(t, c.project, "fakeProjectID")
+	td.Cmp(t, os.Getenv("OVH_CLOUD_PROJECT_SERVICE"), "fakeProjectID")
+	td.Cmp(t, c.prompt(), "ovhcloud (project fakeProjectID)> ")
+
+	// The project defined before starting the shell is restored
+	c.use([]string{"project"})
+	td.Cmp(t, c.project, "")
+	td.Cmp(t, os.Getenv("OVH_CLOUD_PROJECT_SERVICE"), "envProject")
+	td.Cmp(t, c.prompt(), "ovhcloud> ")
+}
+
+func TestShellUseProjectNotInEnv(t *testing.T) {
+	t.Setenv("OVH_CLOUD_PROJECT_SERVICE", "")
+	os.Unsetenv("OVH_CLOUD_PROJECT_SERVICE")
+
+	c := newShellContext()
+
+	c.use([]string{"project", "fakeProjectID"})
+	td.Cmp(t, os.Getenv("OVH_CLOUD_PROJECT_SERVICE"), "fakeProjectID")
+
+	c.use([]string{"project"})
+	_, ok := os.LookupEnv("OVH_CLOUD_PROJECT_SERVICE")
+	td.CmpFalse(t, ok)
+}
+
+func TestShellUseRegion(t *testing.T) {
+	c := newShellContext()
+
+	c.use([]string{"region", "GRA11"})
+	td.Cmp(t, c.region, "GRA11")
+	td.Cmp(t, c.prompt(), "ovhcloud (region GRA11)> ")
+
+	c.use([]string{"region"})
+	td.Cmp(t, c.region, "")
+
+	// Unknown contexts are ignored
+	c.use([]string{"zone", "a"})
+	td.Cmp(t, c, &shellContext{})
+}
+
+func TestShellContextApply(t *testing.T) {
+	c := &shellContext{}
+
+	// No region in the context
+	td.Cmp(t, c.apply([]string{"cloud", "reference", "list-flavors"}), []string{"cloud", "reference", "list-flavors"})
+
+	c.region = "GRA11"
+	td.Cmp(t, c.apply([]string{"cloud", "reference", "list-flavors"}),
+		[]string{"cloud", "reference", "list-flavors", "--region", "GRA11"})
+
+	// The region given on the command line is kept
+	for _, args := range [][]string{
+		{"cloud", "reference", "list-flavors", "--region", "BHS5"},
+		{"cloud", "reference", "list-flavors", "--region=BHS5"},
+		{"cloud", "reference", "list-flavors", "-r", "BHS5"},
+		{"cloud", "reference", "list-flavors", "-rBHS5"},
+	} {
+		td.Cmp(t, c.apply(args), args)
+	}
+
+	// Commands without --region flag are not changed
+	td.Cmp(t, c.apply([]string{"vps", "list"}), []string{"vps", "list"})
+	td.Cmp(t, c.apply([]string{"unknown"}), []string{"unknown"})
+}
+
+func TestLoadShellHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "shell_history")
+
+	td.Cmp(t, loadShellHistory(""), td.Nil())
+	td.Cmp(t, loadShellHistory(path), td.Nil())
+
+	appendShellHistory(path, "vps list")
+	appendShellHistory(path, "")
+	appendShellHistory(path, "use region GRA11")
+	td.Cmp(t, loadShellHistory(path), []string{"vps list", "use region GRA11"})
+
+	// Only the last lines are kept
+	var content strings.Builder
+	for i := range maxShellHistory + 10 {
+		fmt.Fprintf(&content, "vps get vps-%d\n", i)
+	}
+	td.CmpNoError(t, os.WriteFile(path, []byte(content.String()), 0o600))
+
+	history := loadShellHistory(path)
+	td.Cmp(t, history, td.Len(maxShellHistory))
+	td.Cmp(t, history[0], "vps get vps-10")
+	td.Cmp(t, history[maxShellHistory-1], fmt.Sprintf("vps get vps-%d", maxShellHistory+9))
+}
+
+func TestShellCompletions(t *testing.T) {
+	// The completion of the commands requires a client, no call is made
+	client, err := ovh.NewClient("ovh-eu", "app_key", "app_secret", "consumer_key")
+	td.Require(t).CmpNoError(err)
+	httplib.Client = client
+	t.Cleanup(func() { httplib.Client = nil })
+
+	c := newShellContext()
+
+	td.Cmp(t, c.completions("use "), []string{"project", "region"})
+	td.Cmp(t, c.completions("use r"), []string{"region"})
+	td.Cmp(t, c.completions("exit "), td.Nil())
+	td.Cmp(t, c.completions(`vps "unterminated`), td.Nil())
+
+	// Builtins are completed with the commands
+	td.Cmp(t, c.completions(""), td.SuperBagOf("cloud", "vps", "use", "exit", "quit"))
+	td.Cmp(t, c.completions("ex"), []string{"exit"})
+	td.Cmp(t, c.completions("cloud reference list-f"), []string{"list-flavors"})
+}
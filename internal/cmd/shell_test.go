@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+	httplib "github.com/ovh/ovhcloud-cli/internal/http"
+)
+
+func (ms *MockSuite) TestExecuteNoExit(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-unknown",
+		httpmock.NewStringResponder(404, `{"message": "The requested object (serviceName = vps-unknown) does not exist"}`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-12345"]`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-12345",
+		httpmock.NewStringResponder(200, `{"name": "vps-12345", "state": "running"}`).Once())
+
+	// A failing command doesn't terminate the process
+	_, code, err := cmd.ExecuteNoExit("vps", "get", "vps-unknown", "-o", "json")
+	assert.Cmp(code, 1)
+	assert.Cmp(err, td.ErrorIs(td.Contains("does not exist")))
+	cmd.PostExecute()
+
+	// The next commands can be run
+	out, code, err := cmd.ExecuteNoExit("vps", "list", "-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(code, 0)
+	assert.Cmp(json.RawMessage(out), td.JSON(`[{"name": "vps-12345", "state": "running"}]`))
+}
+
+func (ms *MockSuite) TestShellCmdDryRun(assert, require *td.T) {
+	// Intercept the calls modifying resources like the client of the CLI does
+	client := httplib.Client.Client
+	httplib.Client.Client = &http.Client{Transport: httplib.NewTransport("OVH", http.DefaultTransport)}
+	defer func() {
+		httplib.Client.Client = client
+	}()
+
+	httpmock.RegisterResponder(http.MethodPost, "https://eu.api.ovh.com/v1/vps/vps-12345/stop",
+		httpmock.NewStringResponder(200, `{}`))
+
+	httpmock.RegisterResponder(http.MethodPost, "https://eu.api.ovh.com/v1/vps/vps-12345/start",
+		httpmock.NewStringResponder(200, `{}`))
+
+	input := filepath.Join(assert.TempDir(), "input")
+	require.CmpNoError(os.WriteFile(input, []byte("vps stop vps-12345\nvps start vps-12345\n"), 0o600))
+
+	stdin, err := os.Open(input)
+	require.CmpNoError(err)
+	defer stdin.Close()
+
+	previousStdin := os.Stdin
+	os.Stdin = stdin
+	defer func() {
+		os.Stdin = previousStdin
+	}()
+
+	// The global flags apply to all the commands run in the shell
+	_, err = cmd.Execute("shell", "--dry-run")
+	require.CmpNoError(err)
+	assert.Cmp(httpmock.GetCallCountInfo(), td.SuperMapOf(map[string]int{
+		"POST https://eu.api.ovh.com/v1/vps/vps-12345/stop":  0,
+		"POST https://eu.api.ovh.com/v1/vps/vps-12345/start": 0,
+	}, nil))
+}
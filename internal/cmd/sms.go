@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/sms"
 	"github.com/spf13/cobra"
 )
@@ -55,7 +56,10 @@ func init() {
 	smsEditCmd.Flags().StringVar(&sms.SmsSpec.Templates.EmailSubject, "templates-email-subject", "", "Email subject for templates")
 	smsEditCmd.Flags().StringVar(&sms.SmsSpec.Templates.SmsBody, "templates-sms-body", "", "SMS body for templates")
 	addInteractiveEditorFlag(smsEditCmd)
+	addEditFlags(smsEditCmd)
 	smsCmd.AddCommand(smsEditCmd)
 
+	addArgumentCompletion(smsCmd, "<service_name>", common.CompleteFromEndpoint("/v1/sms", "", false))
+
 	rootCmd.AddCommand(smsCmd)
 }
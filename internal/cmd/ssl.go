@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/ssl"
 	"github.com/spf13/cobra"
 )
@@ -32,5 +33,7 @@ func init() {
 		Run:   ssl.GetSsl,
 	})
 
+	addArgumentCompletion(sslCmd, "<service_name>", common.CompleteFromEndpoint("/v1/ssl", "", false))
+
 	rootCmd.AddCommand(sslCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/sslgateway"
 	"github.com/spf13/cobra"
 )
@@ -47,7 +48,10 @@ func init() {
 	sslgatewayEditCmd.Flags().BoolVar(&sslgateway.SSLGatewaySpec.ServerHttps, "server-https", false, "Contact backend servers over HTTPS")
 	sslgatewayEditCmd.Flags().StringVar(&sslgateway.SSLGatewaySpec.SslConfiguration, "ssl-configuration", "", "SSL configuration (intermediate, internal, modern)")
 	addInteractiveEditorFlag(sslgatewayEditCmd)
+	addEditFlags(sslgatewayEditCmd)
 	sslgatewayCmd.AddCommand(sslgatewayEditCmd)
 
+	addArgumentCompletion(sslgatewayCmd, "<service_name>", common.CompleteFromEndpoint("/v1/sslGateway", "", false))
+
 	rootCmd.AddCommand(sslgatewayCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/storagenetapp"
 	"github.com/spf13/cobra"
 )
@@ -41,7 +42,10 @@ func init() {
 	}
 	storagenetappEditCmd.Flags().StringVar(&storagenetapp.StorageNetAppSpec.Name, "name", "", "Name of the Storage NetApp")
 	addInteractiveEditorFlag(storagenetappEditCmd)
+	addEditFlags(storagenetappEditCmd)
 	storagenetappCmd.AddCommand(storagenetappEditCmd)
 
+	addArgumentCompletion(storagenetappCmd, "<service_name>", common.CompleteFromEndpoint("/v1/storage/netapp", "id", false))
+
 	rootCmd.AddCommand(storagenetappCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/telephony"
 	"github.com/spf13/cobra"
 )
@@ -46,7 +47,10 @@ func init() {
 	telephonyEditCmd.Flags().StringVar(&telephony.TelephonySpec.CreditThreshold.Text, "credit-threshold-text", "", "Text for credit threshold")
 	telephonyEditCmd.Flags().IntVar(&telephony.TelephonySpec.CreditThreshold.Value, "credit-threshold-value", 0, "Value for credit threshold")
 	addInteractiveEditorFlag(telephonyEditCmd)
+	addEditFlags(telephonyEditCmd)
 	telephonyCmd.AddCommand(telephonyEditCmd)
 
+	addArgumentCompletion(telephonyCmd, "<service_name>", common.CompleteFromEndpoint("/v1/telephony", "", false))
+
 	rootCmd.AddCommand(telephonyCmd)
 }
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/ovh/ovhcloud-cli/internal/services/templates"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	templatesCmd := &cobra.Command{
+		Use:   "templates",
+		Short: "Manage the templates used to display the details of resources",
+		Long: `Manage the Markdown templates used to display the details of resources.
+
+The embedded templates can be overridden by files stored in ~/.config/ovhcloud/templates,
+named <service>/<name>.tmpl (e.g. ~/.config/ovhcloud/templates/vps/vps.tmpl).`,
+	}
+
+	// Disable parent pre-run that verifies if the API client is correctly initialized
+	templatesCmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {}
+
+	exportCmd := &cobra.Command{
+		Example: `ovhcloud templates export ~/.config/ovhcloud/templates`,
+		Use:     "export <directory>",
+		Short:   "Export the embedded templates to the given directory, to use them as a base for your own templates",
+		Run:     templates.ExportTemplates,
+		Args:    cobra.ExactArgs(1),
+	}
+	exportCmd.Flags().BoolVar(&templates.ForceExport, "force", false, "Overwrite the existing template files")
+	templatesCmd.AddCommand(exportCmd)
+
+	rootCmd.AddCommand(templatesCmd)
+}
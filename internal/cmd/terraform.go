@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
+	"github.com/ovh/ovhcloud-cli/internal/services/terraform"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	terraformCmd := &cobra.Command{
+		Use:   "terraform",
+		Short: "Tools to manage your existing resources with Terraform",
+	}
+
+	importBlocksCmd := &cobra.Command{
+		Use:   "import-blocks",
+		Short: "Generate Terraform import blocks and resource skeletons for your existing resources",
+		Long: `Enumerate your existing resources and output, for each of them, a Terraform import block and a
+skeleton resource definition for the OVH provider, filled with the current values of the resource.
+
+The following resource types are supported:
+  - ovh_cloud_project_network_private and ovh_cloud_project_network_private_subnet
+  - ovh_cloud_project_kube and ovh_cloud_project_kube_nodepool
+  - ovh_cloud_project_database
+  - ovh_cloud_project_storage (S3 containers)
+  - ovh_cloud_project_user
+  - ovh_domain_zone_record
+  - ovh_ip_reverse
+  - ovh_iam_policy (except the read-only policies managed by OVHcloud)
+
+The cloud project resources are generated for the project given with --cloud-project or the default
+one, and are skipped when no project is configured. Run "terraform plan" after the generation to
+review the attributes to complete.`,
+		Example: `ovhcloud terraform import-blocks > imports.tf
+ovhcloud terraform import-blocks --cloud-project <project_id> --type ovh_cloud_project_kube,ovh_cloud_project_kube_nodepool
+ovhcloud terraform import-blocks --type ovh_domain_zone_record --zone example.com`,
+		Args: cobra.NoArgs,
+		Run:  terraform.GenerateImportBlocks,
+	}
+	importBlocksCmd.Flags().StringVar(&cloud.CloudProject, "cloud-project", "", "Cloud project ID")
+	importBlocksCmd.Flags().StringSliceVar(&terraform.ImportTypes, "type", nil, "Resource types to generate (defaults to all the supported types)")
+	importBlocksCmd.Flags().StringSliceVar(&terraform.ImportZones, "zone", nil, "Domain zones whose records are generated (defaults to all your zones)")
+	importBlocksCmd.RegisterFlagCompletionFunc("type", cobra.FixedCompletions(terraform.ResourceTypes, cobra.ShellCompDirectiveNoFileComp))
+	terraformCmd.AddCommand(importBlocksCmd)
+
+	rootCmd.AddCommand(terraformCmd)
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/ovhcloud-cli/internal/cmd"
+)
+
+func (ms *MockSuite) TestTerraformImportBlocksCmd(assert, require *td.T) {
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "pn-123456",
+				"name": "backend",
+				"vlanId": 10,
+				"regions": [{"region": "GRA9", "openstackId": "6d7a4a0e-9b09-11f0-993b-0050568ce122", "status": "ACTIVE"}]
+			}
+		]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/network/private/pn-123456/subnet",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "subnet-12345",
+				"cidr": "10.0.0.0/24",
+				"gatewayIp": "10.0.0.1",
+				"ipPools": [{"region": "GRA9", "start": "10.0.0.10", "end": "10.0.0.200", "dhcp": true, "network": "10.0.0.0/24"}]
+			}
+		]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/domain/zone/example.com/record",
+		httpmock.NewStringResponder(200, `[5088810241]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/domain/zone/example.com/record/5088810241",
+		httpmock.NewStringResponder(200, `{
+			"id": 5088810241,
+			"zone": "example.com",
+			"subDomain": "www",
+			"fieldType": "A",
+			"target": "51.68.0.12",
+			"ttl": 0
+		}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/ip",
+		httpmock.NewStringResponder(200, `["51.68.0.12/32"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/ip/51.68.0.12%2F32/reverse",
+		httpmock.NewStringResponder(200, `["51.68.0.12"]`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v1/ip/51.68.0.12%2F32/reverse/51.68.0.12",
+		httpmock.NewStringResponder(200, `{"ipReverse": "51.68.0.12", "reverse": "www.example.com."}`))
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/v2/iam/policy",
+		httpmock.NewStringResponder(200, `[
+			{
+				"id": "policy-1",
+				"name": "ADMIN_ACCESS",
+				"readOnly": true,
+				"identities": ["urn:v1:eu:identity:group:xx1111-ovh/ADMIN"],
+				"resources": [{"urn": "urn:v1:eu:resource:account:xx1111-ovh"}],
+				"permissions": {"allow": [{"action": "*"}]}
+			},
+			{
+				"id": "policy-2",
+				"name": "vps-operators",
+				"description": "Operate the VPS",
+				"readOnly": false,
+				"identities": ["urn:v1:eu:identity:group:xx1111-ovh/operators"],
+				"resources": [{"urn": "urn:v1:eu:resource:vps:vps-12345.vps.ovh.net"}],
+				"permissions": {"allow": [{"action": "vps:apiovh:reboot"}, {"action": "vps:apiovh:get"}]}
+			}
+		]`))
+
+	out, err := cmd.Execute("terraform", "import-blocks", "--cloud-project", "fakeProjectID", "--zone", "example.com",
+		"--type", "ovh_cloud_project_network_private,ovh_cloud_project_network_private_subnet,ovh_domain_zone_record,ovh_ip_reverse,ovh_iam_policy",
+		"-o", "json")
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"resources": [
+			{
+				"type": "ovh_cloud_project_network_private",
+				"name": "backend",
+				"id": "fakeProjectID/pn-123456",
+				"attributes": {
+					"service_name": "fakeProjectID",
+					"name": "backend",
+					"regions": ["GRA9"],
+					"vlan_id": 10
+				}
+			},
+			{
+				"type": "ovh_cloud_project_network_private_subnet",
+				"name": "backend_10_0_0_0_24",
+				"id": "fakeProjectID/pn-123456/subnet-12345",
+				"attributes": {
+					"service_name": "fakeProjectID",
+					"network_id": "$${ovh_cloud_project_network_private.backend.id}",
+					"network": "10.0.0.0/24",
+					"no_gateway": false,
+					"region": "GRA9",
+					"start": "10.0.0.10",
+					"end": "10.0.0.200",
+					"dhcp": true
+				}
+			},
+			{
+				"type": "ovh_domain_zone_record",
+				"name": "example_com_www_a",
+				"id": "5088810241.example.com",
+				"attributes": {
+					"zone": "example.com",
+					"subdomain": "www",
+					"fieldtype": "A",
+					"target": "51.68.0.12",
+					"ttl": 0
+				}
+			},
+			{
+				"type": "ovh_ip_reverse",
+				"name": "r_51_68_0_12",
+				"id": "51.68.0.12/32|51.68.0.12",
+				"attributes": {
+					"ip": "51.68.0.12/32",
+					"ip_reverse": "51.68.0.12",
+					"reverse": "www.example.com."
+				}
+			},
+			{
+				"type": "ovh_iam_policy",
+				"name": "vps-operators",
+				"id": "policy-2",
+				"attributes": {
+					"name": "vps-operators",
+					"description": "Operate the VPS",
+					"identities": ["urn:v1:eu:identity:group:xx1111-ovh/operators"],
+					"resources": ["urn:v1:eu:resource:vps:vps-12345.vps.ovh.net"],
+					"allow": ["vps:apiovh:reboot", "vps:apiovh:get"]
+				}
+			}
+		]
+	}`))
+}
+
+func (ms *MockSuite) TestTerraformImportBlocksCmdUnsupportedType(assert, require *td.T) {
+	_, code, err := cmd.ExecuteNoExit("terraform", "import-blocks", "--type", "ovh_vps")
+	assert.Cmp(code, 1)
+	assert.Cmp(err, td.ErrorIs(td.Contains(`unsupported resource type "ovh_vps", supported types are: ovh_cloud_project_network_private, ovh_cloud_project_network_private_subnet, ovh_cloud_project_kube, ovh_cloud_project_kube_nodepool, ovh_cloud_project_database, ovh_cloud_project_storage, ovh_cloud_project_user, ovh_domain_zone_record, ovh_ip_reverse, ovh_iam_policy`)))
+}
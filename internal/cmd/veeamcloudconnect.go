@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/veeamcloudconnect"
 	"github.com/spf13/cobra"
 )
@@ -32,5 +33,7 @@ func init() {
 		Run:   veeamcloudconnect.GetVeeamCloudConnect,
 	})
 
+	addArgumentCompletion(veeamcloudconnectCmd, "<service_name>", common.CompleteFromEndpoint("/v1/veeamCloudConnect", "", false))
+
 	rootCmd.AddCommand(veeamcloudconnectCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/veeamenterprise"
 	"github.com/spf13/cobra"
 )
@@ -32,5 +33,7 @@ func init() {
 		Run:   veeamenterprise.GetVeeamEnterprise,
 	})
 
+	addArgumentCompletion(veeamenterpriseCmd, "<service_name>", common.CompleteFromEndpoint("/v1/veeam/veeamEnterprise", "", false))
+
 	rootCmd.AddCommand(veeamenterpriseCmd)
 }
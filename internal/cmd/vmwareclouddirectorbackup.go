@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/vmwareclouddirectorbackup"
 	"github.com/spf13/cobra"
 )
@@ -44,7 +45,10 @@ func init() {
 		"offers", nil, "List of your VMware Cloud Director backup offers formatted as '<name>:<quotaInTB>' (available names: BRONZE, GOLD, SILVER)",
 	)
 	addInteractiveEditorFlag(vmwareclouddirectorbackupEditCmd)
+	addEditFlags(vmwareclouddirectorbackupEditCmd)
 	vmwareclouddirectorbackupCmd.AddCommand(vmwareclouddirectorbackupEditCmd)
 
+	addArgumentCompletion(vmwareclouddirectorbackupCmd, "<service_name>", common.CompleteFromEndpoint("/v2/vmwareCloudDirector/backup", "id", false))
+
 	rootCmd.AddCommand(vmwareclouddirectorbackupCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/vmwareclouddirectororganization"
 	"github.com/spf13/cobra"
 )
@@ -42,7 +43,10 @@ func init() {
 	vmwareclouddirectororganizationEditCmd.Flags().StringVar(&vmwareclouddirectororganization.VmwareCloudDirectorOrganizationSpec.TargetSpec.Description, "description", "", "Description of the organization")
 	vmwareclouddirectororganizationEditCmd.Flags().StringVar(&vmwareclouddirectororganization.VmwareCloudDirectorOrganizationSpec.TargetSpec.FullName, "full-name", "", "Full name of the organization")
 	addInteractiveEditorFlag(vmwareclouddirectororganizationEditCmd)
+	addEditFlags(vmwareclouddirectororganizationEditCmd)
 	vmwareclouddirectororganizationCmd.AddCommand(vmwareclouddirectororganizationEditCmd)
 
+	addArgumentCompletion(vmwareclouddirectororganizationCmd, "<service_name>", common.CompleteFromEndpoint("/v2/vmwareCloudDirector/organization", "id", false))
+
 	rootCmd.AddCommand(vmwareclouddirectororganizationCmd)
 }
@@ -9,7 +9,6 @@ import (
 	"runtime"
 
 	"github.com/ovh/ovhcloud-cli/internal/assets"
-	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/vps"
 	"github.com/spf13/cobra"
@@ -38,6 +37,23 @@ func init() {
 		Run:   vps.GetVps,
 	})
 
+	// Command to wait for a VPS to match a condition
+	vpsWaitCmd := &cobra.Command{
+		Use:   "wait <service_name>",
+		Short: "Wait for the given VPS to match a condition",
+		Long: `Use this command to wait until the given VPS matches a condition, expressed as a gval expression:
+
+	ovhcloud vps wait <service_name> --for 'state=="running"'
+	ovhcloud vps wait <service_name> --for 'state=="stopped"' --timeout 5m
+
+The command exits in error when the timeout is reached or when the failure condition matches.
+`,
+		Args: cobra.ExactArgs(1),
+		Run:  vps.WaitVps,
+	}
+	addWaitConditionFlags(vpsWaitCmd, "")
+	vpsCmd.AddCommand(vpsWaitCmd)
+
 	// Command to update a single VPS
 	vpsEditCmd := &cobra.Command{
 		Use:   "edit <service_name>",
@@ -50,6 +66,7 @@ func init() {
 	vpsEditCmd.Flags().StringVar(&vps.VpsSpec.NetbootMode, "netboot-mode", "", "Netboot mode of the VPS (local, rescue)")
 	vpsEditCmd.Flags().BoolVar(&vps.VpsSpec.SlaMonitoring, "sla-monitoring", false, "Enable or disable SLA monitoring for the VPS")
 	addInteractiveEditorFlag(vpsEditCmd)
+	addEditFlags(vpsEditCmd)
 	vpsCmd.AddCommand(vpsEditCmd)
 
 	// Snapshot commands
@@ -83,6 +100,7 @@ func init() {
 	}
 	vpsSnapshotEditCmd.Flags().StringVar(&vps.VpsSnapshotSpec.Description, "description", "", "Description of the snapshot")
 	addInteractiveEditorFlag(vpsSnapshotEditCmd)
+	addEditFlags(vpsSnapshotEditCmd)
 	vpsSnapshotCmd.AddCommand(vpsSnapshotEditCmd)
 
 	vpsSnapshotCmd.AddCommand(&cobra.Command{
@@ -208,6 +226,7 @@ func init() {
 	serviceInfoEditCmd.Flags().BoolVar(&common.ServiceInfoSpec.Renew.ManualPayment, "renew-manual-payment", false, "Enable manual payment for renewal")
 	serviceInfoEditCmd.Flags().IntVar(&common.ServiceInfoSpec.Renew.Period, "renew-period", 0, "Renewal period (in months)")
 	addInteractiveEditorFlag(serviceInfoEditCmd)
+	addEditFlags(serviceInfoEditCmd)
 	serviceInfoCmd.AddCommand(serviceInfoEditCmd)
 
 	vpsCmd.AddCommand(&cobra.Command{
@@ -255,6 +274,7 @@ func init() {
 	vpsDiskEditCmd.Flags().IntVar(&vps.VpsDiskSpec.LowFreeSpaceThreshold, "low-free-space-threshold", 0, "Low free space threshold for the disk")
 	vpsDiskEditCmd.Flags().BoolVar(&vps.VpsDiskSpec.Monitoring, "monitoring", false, "Enable or disable monitoring for the disk")
 	addInteractiveEditorFlag(vpsDiskEditCmd)
+	addEditFlags(vpsDiskEditCmd)
 	vpsDiskCmd.AddCommand(vpsDiskEditCmd)
 
 	// VNC Console command
@@ -324,7 +344,7 @@ func init() {
 		Args:  cobra.ExactArgs(1),
 		Run:   vps.StartVps,
 	}
-	vpsStartCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for the start task to complete")
+	addWaitFlags(vpsStartCmd, "Wait for the start task to complete")
 	vpsCmd.AddCommand(vpsStartCmd)
 
 	vpsStopCmd := &cobra.Command{
@@ -333,7 +353,7 @@ func init() {
 		Args:  cobra.ExactArgs(1),
 		Run:   vps.StopVps,
 	}
-	vpsStopCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for the stop task to complete")
+	addWaitFlags(vpsStopCmd, "Wait for the stop task to complete")
 	vpsCmd.AddCommand(vpsStopCmd)
 
 	vpsRebootCmd := &cobra.Command{
@@ -342,7 +362,7 @@ func init() {
 		Args:  cobra.ExactArgs(1),
 		Run:   vps.RebootVps,
 	}
-	vpsRebootCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for the reboot task to complete")
+	addWaitFlags(vpsRebootCmd, "Wait for the reboot task to complete")
 	vpsCmd.AddCommand(vpsRebootCmd)
 
 	// Reinstall command
@@ -365,7 +385,7 @@ func init() {
 		vpsReinstallCmd.Flags().BoolVar(&vps.VpsSSHKeyViaInteractiveSelector, "ssh-key-selector", false, "Use the interactive SSH key selector")
 		vpsReinstallCmd.MarkFlagsMutuallyExclusive("from-file", "editor")
 	}
-	vpsReinstallCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for reinstall to be done before exiting")
+	addWaitFlags(vpsReinstallCmd, "Wait for reinstall to be done before exiting")
 	vpsCmd.AddCommand(vpsReinstallCmd)
 
 	// Secondary DNS Domains commands
@@ -408,7 +428,7 @@ func init() {
 		Args:  cobra.ExactArgs(1),
 		Run:   vps.ChangeVpsPassword,
 	}
-	vpsSetPasswordCmd.Flags().BoolVar(&flags.WaitForTask, "wait", false, "Wait for the task to complete before exiting")
+	addWaitFlags(vpsSetPasswordCmd, "Wait for the task to complete before exiting")
 	vpsCmd.AddCommand(vpsSetPasswordCmd)
 
 	// Tasks command
@@ -419,5 +439,7 @@ func init() {
 		Run:   vps.ListVpsTasks,
 	}))
 
+	addArgumentCompletion(vpsCmd, "<service_name>", common.CompleteFromEndpoint("/v1/vps", "", false))
+
 	rootCmd.AddCommand(vpsCmd)
 }
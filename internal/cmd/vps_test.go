@@ -5,7 +5,9 @@
 package cmd_test
 
 import (
+	"bytes"
 	"encoding/json"
+	"net/http"
 
 	"github.com/jarcoal/httpmock"
 	"github.com/maxatome/go-testdeep/td"
@@ -41,6 +43,123 @@ func (ms *MockSuite) TestVpsListCmd(assert, require *td.T) {
 	]`))
 }
 
+func (ms *MockSuite) TestVpsListCmdCSV(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-12345","vps-67890"]`).Times(2))
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-12345",
+		httpmock.NewStringResponder(200, `{"name": "vps-12345", "displayName": "VPS \"main\", production", "state": "running", "zone": "Region OpenStack: os-waw2", "memoryLimit": 2048, "ipAddresses": ["1.2.3.4", "5.6.7.8"]}`).Times(2))
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-67890",
+		httpmock.NewStringResponder(200, `{"name": "vps-67890", "displayName": "VPS 67890", "state": "stopped", "zone": "Region OpenStack: os-gra1", "memoryLimit": 4096}`).Times(2))
+
+	out, err := cmd.Execute("vps", "ls", "-o", "csv")
+
+	require.CmpNoError(err)
+	assert.String(out, `name,displayName,state,zone
+vps-12345,"VPS ""main"", production",running,Region OpenStack: os-waw2
+vps-67890,VPS 67890,stopped,Region OpenStack: os-gra1
+`)
+
+	out, err = cmd.Execute("vps", "ls", "-o", "tsv", "--columns", "name,memoryLimit memory,ipAddresses")
+
+	require.CmpNoError(err)
+	assert.String(out, "name\tmemory\tipAddresses\n"+
+		"vps-12345\t2048\t\"[\"\"1.2.3.4\"\",\"\"5.6.7.8\"\"]\"\n"+
+		"vps-67890\t4096\t\n")
+}
+
+func (ms *MockSuite) TestVpsListCmdNDJSON(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-12345","vps-67890"]`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-12345",
+		httpmock.NewStringResponder(200, `{"name": "vps-12345", "state": "running", "memoryLimit": 2048}`).Times(2))
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-67890",
+		httpmock.NewStringResponder(200, `{"name": "vps-67890", "state": "stopped", "memoryLimit": 4096}`).Once())
+
+	out, err := cmd.Execute("vps", "ls", "-o", "ndjson")
+
+	require.CmpNoError(err)
+	assert.String(out, `{"memoryLimit":2048,"name":"vps-12345","state":"running"}
+{"memoryLimit":4096,"name":"vps-67890","state":"stopped"}
+`)
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-12345/datacenter",
+		httpmock.NewStringResponder(200, `{"country": "pl", "name": "os-waw2"}`).Once())
+
+	out, err = cmd.Execute("vps", "get", "vps-12345", "-o", "ndjson")
+
+	require.CmpNoError(err)
+	assert.String(out, `{"datacenter":{"country":"pl","name":"os-waw2"},"memoryLimit":2048,"name":"vps-12345","state":"running"}
+`)
+}
+
+func (ms *MockSuite) TestVpsListCmdSortAndLimit(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-1","vps-2","vps-3"]`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-1",
+		httpmock.NewStringResponder(200, `{"name": "vps-1", "state": "running", "memoryLimit": 2048}`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-2",
+		httpmock.NewStringResponder(200, `{"name": "vps-2", "state": "stopped", "memoryLimit": 8192}`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-3",
+		httpmock.NewStringResponder(200, `{"name": "vps-3", "state": "running", "memoryLimit": 4096}`).Once())
+
+	out, err := cmd.Execute("vps", "ls", "-o", "json", "--sort-by", "memoryLimit", "--desc", "--limit", "2", "--columns", "name,memoryLimit memory")
+
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`[
+		{"name": "vps-2", "memory": 8192},
+		{"name": "vps-3", "memory": 4096}
+	]`))
+
+}
+
+func (ms *MockSuite) TestVpsListCmdDefaultColumns(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-1","vps-2","vps-3"]`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-1",
+		httpmock.NewStringResponder(200, `{"name": "vps-1", "state": "running", "zone": "os-waw2"}`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-2",
+		httpmock.NewStringResponder(200, `{"name": "vps-2", "state": "stopped", "zone": "os-gra1"}`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-3",
+		httpmock.NewStringResponder(200, `{"name": "vps-3", "state": "running", "zone": "os-gra1"}`).Once())
+
+	withTempConfig(require, map[string]string{
+		"user": "[ovh-cli columns]\nvps list = name,zone\n",
+	})
+
+	out, err := cmd.Execute("vps", "ls", "--sort-by", "zone + name")
+
+	require.CmpNoError(err)
+	assert.Cmp(out, td.Re(`(?s)│ name  │  zone   │.*│ vps-2 │ os-gra1 │.*│ vps-3 │ os-gra1 │.*│ vps-1 │ os-waw2 │`))
+	assert.Not(out, td.Contains("state"))
+}
+
+func (ms *MockSuite) TestVpsListCmdTemplate(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-12345","vps-67890"]`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-12345",
+		httpmock.NewStringResponder(200, `{"name": "vps-12345", "state": "running", "zone": "os-waw2"}`).Once())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-67890",
+		httpmock.NewStringResponder(200, `{"name": "vps-67890", "state": "stopped"}`).Once())
+
+	out, err := cmd.Execute("vps", "ls", "-o", `template={{ range .Result }}{{ .name }} is {{ .state }} in {{ .zone | default "?" }}
+{{ end }}`)
+
+	require.CmpNoError(err)
+	assert.String(out, "vps-12345 is running in os-waw2\nvps-67890 is stopped in ?\n")
+}
+
 func (ms *MockSuite) TestVpsGetCmd(assert, require *td.T) {
 	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-67890",
 		httpmock.NewStringResponder(200, `{"name": "vps-67890", "displayName": "VPS 67890", "state": "stopped", "zone": "Region OpenStack: os-gra1"}`).Once())
@@ -63,3 +182,54 @@ func (ms *MockSuite) TestVpsGetCmd(assert, require *td.T) {
 		}
 	}`))
 }
+
+func (ms *MockSuite) TestVpsWaitCmd(assert, require *td.T) {
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps/vps-12345",
+		httpmock.ResponderFromMultipleResponses([]*http.Response{
+			httpmock.NewStringResponse(200, `{"name": "vps-12345", "state": "rebooting", "memoryLimit": 2048}`),
+			httpmock.NewStringResponse(200, `{"name": "vps-12345", "state": "running", "memoryLimit": 2048}`),
+		}))
+
+	out, err := cmd.Execute("vps", "wait", "vps-12345", "--for", `state=="running" && memoryLimit > 1024`, "--poll-interval", "10ms", "-o", "json")
+
+	require.CmpNoError(err)
+	assert.Cmp(json.RawMessage(out), td.JSON(`{
+		"message": "✅ VPS vps-12345 matches condition state==\"running\" && memoryLimit > 1024",
+		"details": {
+			"description": "VPS vps-12345",
+			"status": "running",
+			"attempts": 2,
+			"elapsed": "0s",
+			"resource": {
+				"name": "vps-12345",
+				"state": "running",
+				"memoryLimit": 2048
+			}
+		}
+	}`))
+}
+
+func (ms *MockSuite) TestVpsGetCmdCompletion(assert, require *td.T) {
+	require.Setenv("XDG_CACHE_HOME", require.TempDir())
+
+	httpmock.RegisterResponder("GET", "https://eu.api.ovh.com/v1/vps",
+		httpmock.NewStringResponder(200, `["vps-12345","vps-67890"]`).Once())
+
+	var out bytes.Buffer
+	cmd.GetRootCommand().SetOut(&out)
+	defer cmd.GetRootCommand().SetOut(nil)
+
+	// The second completion is served from the cache
+	for range 2 {
+		out.Reset()
+		_, err := cmd.Execute("__complete", "vps", "get", "")
+		require.CmpNoError(err)
+		assert.String(out.String(), "vps-12345\nvps-67890\n:4\n")
+	}
+
+	// Only the first argument is completed
+	out.Reset()
+	_, err := cmd.Execute("__complete", "vps", "disk", "get", "vps-12345", "")
+	require.CmpNoError(err)
+	assert.String(out.String(), ":4\n")
+}
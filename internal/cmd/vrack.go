@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/vrack"
 	"github.com/spf13/cobra"
 )
@@ -42,7 +43,10 @@ func init() {
 	vrackEditCmd.Flags().StringVar(&vrack.VrackSpec.Name, "name", "", "Name of the vRack")
 	vrackEditCmd.Flags().StringVar(&vrack.VrackSpec.Description, "description", "", "Description of the vRack")
 	addInteractiveEditorFlag(vrackEditCmd)
+	addEditFlags(vrackEditCmd)
 	vrackCmd.AddCommand(vrackEditCmd)
 
+	addArgumentCompletion(vrackCmd, "<service_name>", common.CompleteFromEndpoint("/v1/vrack", "", false))
+
 	rootCmd.AddCommand(vrackCmd)
 }
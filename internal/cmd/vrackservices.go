@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/vrackservices"
 	"github.com/spf13/cobra"
 )
@@ -33,12 +34,16 @@ func init() {
 	})
 
 	// Command to update a single VrackServices
-	vrackservicesCmd.AddCommand(&cobra.Command{
+	vrackservicesEditCmd := &cobra.Command{
 		Use:   "edit <service_name>",
 		Short: "Edit the given vRackServices",
 		Args:  cobra.ExactArgs(1),
 		Run:   vrackservices.EditVrackServices,
-	})
+	}
+	addEditFlags(vrackservicesEditCmd)
+	vrackservicesCmd.AddCommand(vrackservicesEditCmd)
+
+	addArgumentCompletion(vrackservicesCmd, "<service_name>", common.CompleteFromEndpoint("/v2/vrackServices/resource", "id", false))
 
 	rootCmd.AddCommand(vrackservicesCmd)
 }
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/webhosting"
 	"github.com/spf13/cobra"
 )
@@ -41,7 +42,10 @@ func init() {
 	}
 	webhostingEditCmd.Flags().StringVar(&webhosting.WebHostingSpec.DisplayName, "display-name", "", "Display name of the WebHosting")
 	addInteractiveEditorFlag(webhostingEditCmd)
+	addEditFlags(webhostingEditCmd)
 	webhostingCmd.AddCommand(webhostingEditCmd)
 
+	addArgumentCompletion(webhostingCmd, "<service_name>", common.CompleteFromEndpoint("/v1/hosting/web", "", false))
+
 	rootCmd.AddCommand(webhostingCmd)
 }
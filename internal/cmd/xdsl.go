@@ -7,6 +7,7 @@ package cmd
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/services/xdsl"
 	"github.com/spf13/cobra"
 )
@@ -45,7 +46,10 @@ func init() {
 	xdslEditCmd.Flags().IntVar(&xdsl.XdslSpec.LnsRateLimit, "lns-rate-limit", 0, "Rate limit on the LNS in kbps. Must be a multiple of 64 - Min value 64 / Max value 100032")
 	xdslEditCmd.Flags().BoolVar(&xdsl.XdslSpec.Monitoring, "monitoring", false, "Enable monitoring of the access")
 	addInteractiveEditorFlag(xdslEditCmd)
+	addEditFlags(xdslEditCmd)
 	xdslCmd.AddCommand(xdslEditCmd)
 
+	addArgumentCompletion(xdslCmd, "<service_name>", common.CompleteFromEndpoint("/v1/xdsl", "", false))
+
 	rootCmd.AddCommand(xdslCmd)
 }
@@ -5,9 +5,11 @@
 package config
 
 import (
+	"encoding/csv"
 	"fmt"
 	"os"
 	"os/user"
+	"slices"
 	"strings"
 
 	"gopkg.in/ini.v1"
@@ -23,12 +25,45 @@ var (
 		"./ovh.conf",
 	}
 
+	// ConfigScopes are the names of the scopes of each file of ConfigPaths
+	ConfigScopes = []string{"system", "user", "local"}
+
+	// TemplatesDir contains the user templates overriding the embedded ones,
+	// as <service>/<name>.tmpl files
+	TemplatesDir = "~/.config/ovhcloud/templates"
+
 	ConfigurableFields = map[string]string{
 		"endpoint":              "default",
 		"default_cloud_project": "ovh-cli",
+		"profile":               "ovh-cli",
+		"max_retries":           "ovh-cli",
+		"parallel":              "ovh-cli",
+		"rate_limit":            "ovh-cli",
+	}
+
+	// ProfileFields are the fields that can be stored in a profile section
+	ProfileFields = []string{
+		"endpoint",
+		"application_key",
+		"application_secret",
+		"consumer_key",
+		"client_id",
+		"client_secret",
+		"access_token",
+		"default_cloud_project",
 	}
+
+	// ActiveProfile is the name of the profile in use. When set, the fields
+	// of ProfileFields are read from and written to the profile section.
+	ActiveProfile string
 )
 
+const profileSectionPrefix = "profile "
+
+// ColumnsSectionName is the section defining the default columns of list commands,
+// indexed by command path (e.g. "cloud instance list = id,name,status").
+const ColumnsSectionName = "ovh-cli columns"
+
 // currentUserHome attempts to get current user's home directory.
 func currentUserHome() (string, error) {
 	usr, err := user.Current()
@@ -43,49 +78,72 @@ func currentUserHome() (string, error) {
 	return usr.HomeDir, nil
 }
 
+// expandPath returns the given path with ~/ prefix expanded.
+func expandPath(path string) (string, error) {
+	if !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := currentUserHome()
+	if err != nil {
+		return "", err
+	}
+
+	return home + path[1:], nil
+}
+
+// ExpandTemplatesDir returns TemplatesDir, with ~/ prefix expanded.
+func ExpandTemplatesDir() (string, error) {
+	return expandPath(TemplatesDir)
+}
+
 // configPaths returns configPaths, with ~/ prefix expanded.
 func ExpandConfigPaths() []string {
 	paths := []string{}
 
-	// Will be initialized on first use
-	var home string
-	var homeErr error
-
 	for _, path := range ConfigPaths {
-		if strings.HasPrefix(path, "~/") {
-			// Find home if needed
-			if home == "" && homeErr == nil {
-				home, homeErr = currentUserHome()
-			}
-			// Ignore file in HOME if we cannot find it
-			if homeErr != nil {
-				continue
-			}
-
-			path = home + path[1:]
+		// Ignore file in HOME if we cannot find it
+		expandedPath, err := expandPath(path)
+		if err != nil {
+			continue
 		}
 
-		paths = append(paths, path)
+		paths = append(paths, expandedPath)
 	}
 
 	return paths
 }
 
-// loadINI builds a ini.File from the configuration paths provided in configPaths.
-// It's a helper for loadConfig.
+// LoadINI merges all the configuration files found in ConfigPaths, later ones
+// taking precedence over the previous ones. It returns the merged configuration
+// and the path of the configuration file having the highest priority.
 func LoadINI() (*ini.File, string) {
-	paths := ExpandConfigPaths()
-	if len(paths) == 0 {
-		return ini.Empty(), ""
-	}
+	Layers = nil
 
-	for _, path := range paths {
-		if cfg, err := ini.Load(path); err == nil {
-			return cfg, path
+	var path string
+	for idx, configPath := range ConfigPaths {
+		expandedPath, err := expandPath(configPath)
+		if err != nil {
+			continue
 		}
+
+		file, err := ini.Load(expandedPath)
+		if err != nil {
+			continue
+		}
+
+		Layers = append(Layers, &Layer{
+			Scope: scopeName(idx),
+			Path:  expandedPath,
+			File:  file,
+		})
+		path = expandedPath
 	}
 
-	return ini.Empty(), ""
+	cfg := ini.Empty()
+	mergeLayers(cfg)
+
+	return cfg, path
 }
 
 // getConfigValue returns the value of OVH_<NAME> or "name" value from "section". If
@@ -98,61 +156,190 @@ func getConfigValue(cfg *ini.File, section, name, defaultValue string) string {
 	}
 
 	// Attempt to load from configuration
-	fromSection := cfg.Section(section)
-	if fromSection == nil {
-		return defaultValue
+	if value, ok := fileValue(cfg, section, name); ok {
+		return value
 	}
 
-	fromSectionKey := fromSection.Key(name)
-	if fromSectionKey == nil {
-		return defaultValue
+	return defaultValue
+}
+
+// configSectionName returns the section in which the given key is stored
+// when no section is explicitly requested.
+func configSectionName(keyName string) (string, error) {
+	if ActiveProfile != "" && slices.Contains(ProfileFields, keyName) {
+		return ProfileSectionName(ActiveProfile), nil
 	}
 
-	return fromSectionKey.String()
+	sectionName := ConfigurableFields[keyName]
+	if sectionName == "" {
+		return "", fmt.Errorf("unknown configuration field %q", keyName)
+	}
+
+	return sectionName, nil
 }
 
 func GetConfigValue(cfg *ini.File, sectionName, keyName string) (string, error) {
 	if sectionName == "" {
-		sectionName = ConfigurableFields[keyName]
-		if sectionName == "" {
-			return "", fmt.Errorf("unknown configuration field %q", keyName)
+		var err error
+		sectionName, err = configSectionName(keyName)
+		if err != nil {
+			return "", err
 		}
 	}
 
 	return getConfigValue(cfg, sectionName, keyName, ""), nil
 }
 
-func SetConfigValue(cfg *ini.File, path, sectionName, keyName, value string) error {
-	if path == "" {
-		path = ConfigPaths[0]
+// SetConfigValue writes the given value in the configuration file of the given scope.
+// If no scope is given, the value is written in the file that currently defines it.
+func SetConfigValue(cfg *ini.File, scope, sectionName, keyName, value string) error {
+	if sectionName == "" {
+		var err error
+		sectionName, err = configSectionName(keyName)
+		if err != nil {
+			return err
+		}
 	}
 
-	if sectionName == "" {
-		sectionName = ConfigurableFields[keyName]
-		if sectionName == "" {
-			return fmt.Errorf("unknown configuration field %q", keyName)
+	layer, err := writeLayer(scope, func(file *ini.File) bool {
+		section, err := file.GetSection(sectionName)
+		return err == nil && section.HasKey(keyName)
+	})
+	if err != nil {
+		return err
+	}
+
+	layer.File.Section(sectionName).Key(keyName).SetValue(value)
+
+	return saveLayers(cfg, layer)
+}
+
+// GetDefaultColumns returns the columns defined in the configuration for the given
+// list command. They are given as a comma-separated list, with the same quoting
+// rules as the --columns flag.
+func GetDefaultColumns(cfg *ini.File, commandPath string) ([]string, error) {
+	value, ok := fileValue(cfg, ColumnsSectionName, commandPath)
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	columns, err := csv.NewReader(strings.NewReader(value)).Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid columns %q for command %q: %w", value, commandPath, err)
+	}
+
+	return columns, nil
+}
+
+// ProfileSectionName returns the name of the section holding the given profile.
+func ProfileSectionName(name string) string {
+	return profileSectionPrefix + name
+}
+
+// ResolveProfile returns the name of the profile to use. The given flag value
+// takes precedence over OVH_PROFILE environment variable, which itself takes
+// precedence over the profile defined in the configuration.
+func ResolveProfile(cfg *ini.File, flagValue string) string {
+	if flagValue != "" {
+		return flagValue
+	}
+
+	return getConfigValue(cfg, ConfigurableFields["profile"], "profile", "")
+}
+
+// ListProfiles returns the sorted names of the profiles defined in the configuration.
+func ListProfiles(cfg *ini.File) []string {
+	var profiles []string
+
+	for _, name := range cfg.SectionStrings() {
+		if profile, ok := strings.CutPrefix(name, profileSectionPrefix); ok {
+			profiles = append(profiles, profile)
 		}
 	}
+	slices.Sort(profiles)
 
-	var err error
+	return profiles
+}
 
-	section := cfg.Section(sectionName)
-	if section == nil {
-		section, err = cfg.NewSection("ovh-cli")
-		if err != nil {
-			return err
+// GetProfile returns the values defined in the given profile.
+func GetProfile(cfg *ini.File, name string) (map[string]string, error) {
+	section, err := cfg.GetSection(ProfileSectionName(name))
+	if err != nil {
+		return nil, fmt.Errorf("profile %q not found", name)
+	}
+
+	values := make(map[string]string)
+	for _, name := range ProfileFields {
+		if key, err := section.GetKey(name); err == nil && key.String() != "" {
+			values[name] = key.String()
 		}
 	}
 
-	key := section.Key(keyName)
-	if section == nil {
-		_, err = section.NewKey(keyName, value)
+	return values, nil
+}
+
+// SetProfile writes the given values in the given profile, creating it if needed.
+// If no scope is given, the profile is written in the file that currently defines it.
+func SetProfile(cfg *ini.File, scope, name string, values map[string]string) error {
+	for key := range values {
+		if !slices.Contains(ProfileFields, key) {
+			return fmt.Errorf("unknown profile field %q", key)
+		}
+	}
+
+	layer, err := writeLayer(scope, func(file *ini.File) bool {
+		return file.HasSection(ProfileSectionName(name))
+	})
+	if err != nil {
+		return err
+	}
+
+	section := layer.File.Section(ProfileSectionName(name))
+	for _, key := range ProfileFields {
+		if value, ok := values[key]; ok {
+			section.Key(key).SetValue(value)
+		}
+	}
+
+	return saveLayers(cfg, layer)
+}
+
+// RemoveProfile deletes the given profile from the configuration file of the
+// given scope, or from all the configuration files if no scope is given.
+func RemoveProfile(cfg *ini.File, scope, name string) error {
+	if !cfg.HasSection(ProfileSectionName(name)) {
+		return fmt.Errorf("profile %q not found", name)
+	}
+
+	var layers []*Layer
+	if scope != "" {
+		layer, err := scopeLayer(scope)
 		if err != nil {
 			return err
 		}
+		layers = append(layers, layer)
 	} else {
-		key.SetValue(value)
+		layers = Layers
+	}
+
+	var updatedLayers []*Layer
+	for _, layer := range layers {
+		if !layer.File.HasSection(ProfileSectionName(name)) {
+			continue
+		}
+		layer.File.DeleteSection(ProfileSectionName(name))
+
+		// Unset the active profile if it was the removed one
+		if activeProfile, _ := fileValue(layer.File, ConfigurableFields["profile"], "profile"); activeProfile == name {
+			layer.File.Section(ConfigurableFields["profile"]).DeleteKey("profile")
+		}
+
+		updatedLayers = append(updatedLayers, layer)
+	}
+
+	if len(updatedLayers) == 0 {
+		return fmt.Errorf("profile %q not found in %s configuration", name, scope)
 	}
 
-	return cfg.SaveTo(path)
+	return saveLayers(cfg, updatedLayers...)
 }
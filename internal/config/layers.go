@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/ini.v1"
+)
+
+// Layer is one of the configuration files merged in the CLI configuration
+type Layer struct {
+	// Scope of the configuration file (system, user or local)
+	Scope string
+	Path  string
+	File  *ini.File
+}
+
+// Layers are the configuration files loaded by LoadINI, by increasing priority
+var Layers []*Layer
+
+// defaultScope is the scope in which values are written when
+// no scope is given and no configuration file exists yet
+const defaultScope = "user"
+
+// scopeName returns the scope of the configuration path at the given index of ConfigPaths
+func scopeName(idx int) string {
+	if idx < len(ConfigScopes) {
+		return ConfigScopes[idx]
+	}
+
+	return ""
+}
+
+// fileValue returns the value of the given key defined in the given file, without
+// creating the section or the key if they don't exist
+func fileValue(file *ini.File, sectionName, keyName string) (string, bool) {
+	section, err := file.GetSection(sectionName)
+	if err != nil {
+		return "", false
+	}
+
+	key, err := section.GetKey(keyName)
+	if err != nil {
+		return "", false
+	}
+
+	return key.String(), true
+}
+
+// scopeLayer returns the layer of the given scope. An empty layer is
+// created if the corresponding configuration file does not exist yet.
+func scopeLayer(scope string) (*Layer, error) {
+	idx := slices.Index(ConfigScopes, scope)
+	if idx < 0 || idx >= len(ConfigPaths) {
+		return nil, fmt.Errorf("invalid configuration scope %q, valid scopes are: %s", scope, strings.Join(ConfigScopes, ", "))
+	}
+
+	for _, layer := range Layers {
+		if layer.Scope == scope {
+			return layer, nil
+		}
+	}
+
+	path, err := expandPath(ConfigPaths[idx])
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve path of %s configuration: %w", scope, err)
+	}
+
+	// Insert the new layer according to its priority
+	layer := &Layer{Scope: scope, Path: path, File: ini.Empty()}
+	position := len(Layers)
+	for i, existing := range Layers {
+		if slices.Index(ConfigScopes, existing.Scope) > idx {
+			position = i
+			break
+		}
+	}
+	Layers = slices.Insert(Layers, position, layer)
+
+	return layer, nil
+}
+
+// writeLayer returns the layer in which a value must be written: the one of the given
+// scope, otherwise the layer having the highest priority among the ones already defining
+// the value, or among all the existing ones.
+func writeLayer(scope string, defines func(*ini.File) bool) (*Layer, error) {
+	if scope != "" {
+		return scopeLayer(scope)
+	}
+
+	for _, layer := range slices.Backward(Layers) {
+		if defines(layer.File) {
+			return layer, nil
+		}
+	}
+
+	if len(Layers) > 0 {
+		return Layers[len(Layers)-1], nil
+	}
+
+	return scopeLayer(defaultScope)
+}
+
+// saveLayers writes the given layers to their file, and merges all the layers in cfg
+func saveLayers(cfg *ini.File, layers ...*Layer) error {
+	for _, layer := range layers {
+		if err := layer.File.SaveTo(layer.Path); err != nil {
+			return err
+		}
+	}
+
+	mergeLayers(cfg)
+
+	return nil
+}
+
+// mergeLayers replaces the content of cfg with the values of all the layers
+func mergeLayers(cfg *ini.File) {
+	for _, name := range cfg.SectionStrings() {
+		cfg.DeleteSection(name)
+	}
+
+	for _, layer := range Layers {
+		for _, section := range layer.File.Sections() {
+			merged := cfg.Section(section.Name())
+			for _, key := range section.Keys() {
+				merged.Key(key.Name()).SetValue(key.Value())
+			}
+		}
+	}
+}
+
+// ConfigSource returns the path of the configuration file defining the effective
+// value of the given key, or an empty string if the key is not defined.
+func ConfigSource(sectionName, keyName string) string {
+	for _, layer := range slices.Backward(Layers) {
+		if _, ok := fileValue(layer.File, sectionName, keyName); ok {
+			return layer.Path
+		}
+	}
+
+	return ""
+}
+
+// GetEffectiveValue returns the value of the given key and its source, which is either
+// the environment variable overriding it, or the configuration file defining it.
+func GetEffectiveValue(cfg *ini.File, sectionName, keyName string) (string, string) {
+	envName := "OVH_" + strings.ToUpper(keyName)
+	if fromEnv := os.Getenv(envName); fromEnv != "" {
+		return fromEnv, "$" + envName
+	}
+
+	value, _ := fileValue(cfg, sectionName, keyName)
+
+	return value, ConfigSource(sectionName, keyName)
+}
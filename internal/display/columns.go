@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package display
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PaesslerAG/gval"
+)
+
+// parseColumns returns the titles of the given columns and the selectors used to
+// extract their values. A column is a gval selector, optionally followed by a
+// space and an alias used as column title.
+func parseColumns(columns []string) ([]string, gval.Evaluables, error) {
+	var (
+		titles    = make([]string, 0, len(columns))
+		selectors = make(gval.Evaluables, 0, len(columns))
+	)
+
+	for _, col := range columns {
+		// If column to display contains an alias, use it as column title
+		colName, alias, ok := strings.Cut(col, " ")
+		if ok {
+			col = colName
+			titles = append(titles, alias)
+		} else {
+			titles = append(titles, col)
+		}
+
+		// Create selector to extract value at given key
+		evaluator, err := gval.Base().NewEvaluable(col)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid column to display %q: %w", col, err)
+		}
+		selectors = append(selectors, evaluator)
+	}
+
+	return titles, selectors, nil
+}
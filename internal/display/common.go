@@ -4,30 +4,99 @@
 
 package display
 
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// Prefixes of the output formats rendering a Go template given inline or in a file
+const (
+	templateOutputPrefix     = "template="
+	templateFileOutputPrefix = "template-file="
+)
+
+// InterruptedExitCode is the exit code of the CLI when a command is
+// interrupted by the user (Ctrl-C) or terminated by a signal
+const InterruptedExitCode = 130
+
 var (
 	ResultError  error
 	ResultString string
+
+	// Interrupted is set when the running command is cancelled by a signal
+	Interrupted atomic.Bool
+
+	// Exit terminates the CLI once a result has been displayed. It is replaced when
+	// several commands are run in the same process, to only stop the current command.
+	Exit = os.Exit
 )
 
 // OutputFormat controls the output format of the CLI.
-// The Output field can be "json", "yaml", "interactive", or a custom gval expression.
+// The Output field can be "json", "yaml", "ndjson", "interactive", "csv", "tsv", "template=<template>",
+// "template-file=<path>", or a custom gval expression.
 type OutputFormat struct {
 	Output string
+
+	// Columns overrides the columns displayed by list commands
+	Columns []string
+
+	// DefaultColumns overrides the default columns of the running list command,
+	// as defined in the configuration
+	DefaultColumns []string
+
+	// SortBy is a gval expression used to sort the items of lists, in
+	// descending order if Desc is set
+	SortBy string
+	Desc   bool
+
+	// Limit is the maximum number of items of lists to display, 0 for no limit
+	Limit int
 }
 
 func (o *OutputFormat) IsJson() bool        { return o.Output == "json" }
 func (o *OutputFormat) IsYaml() bool        { return o.Output == "yaml" }
+func (o *OutputFormat) IsNdjson() bool      { return o.Output == "ndjson" }
 func (o *OutputFormat) IsInteractive() bool { return o.Output == "interactive" }
+func (o *OutputFormat) IsCsv() bool         { return o.Output == "csv" }
+func (o *OutputFormat) IsTsv() bool         { return o.Output == "tsv" }
+func (o *OutputFormat) IsTemplate() bool {
+	return strings.HasPrefix(o.Output, templateOutputPrefix) || strings.HasPrefix(o.Output, templateFileOutputPrefix)
+}
 func (o *OutputFormat) CustomFormat() string {
-	if o.Output != "" && !o.IsJson() && !o.IsYaml() && !o.IsInteractive() {
+	if o.Output != "" && !o.IsJson() && !o.IsYaml() && !o.IsNdjson() && !o.IsInteractive() &&
+		!o.IsCsv() && !o.IsTsv() && !o.IsTemplate() {
 		return o.Output
 	}
 	return ""
 }
 
+// Template returns the content of the Go template given with -o template=<template>
+// or read from the file given with -o template-file=<path>.
+func (o *OutputFormat) Template() (string, error) {
+	if path, ok := strings.CutPrefix(o.Output, templateFileOutputPrefix); ok {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read template file: %w", err)
+		}
+		return string(content), nil
+	}
+
+	return strings.TrimPrefix(o.Output, templateOutputPrefix), nil
+}
+
+// IsStreamable returns whether the items of a list can be rendered one by one
+// using RenderRow, as soon as they are fetched. This is not the case when the
+// list has to be sorted or limited.
+func (o *OutputFormat) IsStreamable() bool {
+	return (o.CustomFormat() != "" || o.IsNdjson()) && o.SortBy == "" && o.Limit == 0
+}
+
 type OutputMessage struct {
-	Message string `json:"message,omitempty"`
-	Error   bool   `json:"error,omitempty"`
-	Warning bool   `json:"warning,omitempty"`
-	Details any    `json:"details,omitempty"`
+	Message     string `json:"message,omitempty"`
+	Error       bool   `json:"error,omitempty"`
+	Warning     bool   `json:"warning,omitempty"`
+	Interrupted bool   `json:"interrupted,omitempty"`
+	Details     any    `json:"details,omitempty"`
 }
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package display
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// renderDelimited renders the given values as CSV, or TSV when using a tab separator.
+// The first line contains the titles of the given columns. Nested values are written
+// as JSON strings, and no value is truncated.
+func renderDelimited(values []map[string]any, columnsToDisplay []string, separator rune) error {
+	titles, selectors, err := parseColumns(columnsToDisplay)
+	if err != nil {
+		return err
+	}
+
+	var (
+		output bytes.Buffer
+		writer = csv.NewWriter(&output)
+	)
+	writer.Comma = separator
+
+	if err := writer.Write(titles); err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		record := make([]string, 0, len(selectors))
+		for _, selector := range selectors {
+			val, err := selector(context.Background(), value)
+			if err != nil {
+				return fmt.Errorf("failed to select row field: %w", err)
+			}
+
+			cell, err := delimitedCell(val)
+			if err != nil {
+				return err
+			}
+			record = append(record, cell)
+		}
+
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+
+	ResultString = output.String()
+	fmt.Print(ResultString)
+
+	return nil
+}
+
+// delimitedCell returns the textual representation of a CSV or TSV cell
+func delimitedCell(value any) (string, error) {
+	switch val := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return val, nil
+	case json.Number:
+		return val.String(), nil
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	case bool, int, int64:
+		return fmt.Sprint(val), nil
+	default:
+		out, err := json.Marshal(val)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal cell value: %w", err)
+		}
+		return string(out), nil
+	}
+}
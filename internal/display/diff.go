@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package display
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Kinds of the changes of a field between two versions of a resource
+const (
+	DiffAdded    = "added"
+	DiffRemoved  = "removed"
+	DiffModified = "modified"
+)
+
+// DiffChange is the change of a single field between two versions of a resource
+type DiffChange struct {
+	Kind string `json:"kind"`
+	Path string `json:"path"`
+	Old  any    `json:"old,omitempty"`
+	New  any    `json:"new,omitempty"`
+}
+
+// ComputeDiff returns the field-level changes between the given versions of a
+// resource, sorted by path. Objects are compared field by field and arrays of the
+// same length item by item, other values are compared using their JSON encoding
+// so that numbers decoded differently are still considered equal.
+func ComputeDiff(before, after map[string]any) []DiffChange {
+	var changes []DiffChange
+	diffValues("", before, after, &changes)
+
+	slices.SortStableFunc(changes, func(a, b DiffChange) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return changes
+}
+
+func diffValues(path string, before, after any, changes *[]DiffChange) {
+	switch beforeValue := before.(type) {
+	case map[string]any:
+		afterValue, ok := after.(map[string]any)
+		if !ok {
+			break
+		}
+
+		for key, value := range beforeValue {
+			fieldPath := joinDiffPath(path, key)
+			if newValue, ok := afterValue[key]; ok {
+				diffValues(fieldPath, value, newValue, changes)
+			} else {
+				*changes = append(*changes, DiffChange{Kind: DiffRemoved, Path: fieldPath, Old: value})
+			}
+		}
+		for key, value := range afterValue {
+			if _, ok := beforeValue[key]; !ok {
+				*changes = append(*changes, DiffChange{Kind: DiffAdded, Path: joinDiffPath(path, key), New: value})
+			}
+		}
+		return
+
+	case []any:
+		afterValue, ok := after.([]any)
+		if !ok || len(beforeValue) != len(afterValue) {
+			break
+		}
+
+		for i := range beforeValue {
+			diffValues(fmt.Sprintf("%s[%d]", path, i), beforeValue[i], afterValue[i], changes)
+		}
+		return
+	}
+
+	beforeJSON, _ := json.Marshal(before)
+	afterJSON, _ := json.Marshal(after)
+	if !bytes.Equal(beforeJSON, afterJSON) {
+		*changes = append(*changes, DiffChange{Kind: DiffModified, Path: path, Old: before, New: after})
+	}
+}
+
+func joinDiffPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// RenderDiff writes the given changes to the given writer, one line per field,
+// coloured when the writer is a terminal.
+func RenderDiff(w io.Writer, changes []DiffChange) {
+	var (
+		renderer      = lipgloss.NewRenderer(w)
+		addedStyle    = renderer.NewStyle().Foreground(lipgloss.Color("2"))
+		removedStyle  = renderer.NewStyle().Foreground(lipgloss.Color("1"))
+		modifiedStyle = renderer.NewStyle().Foreground(lipgloss.Color("3"))
+	)
+
+	for _, change := range changes {
+		var line string
+		switch change.Kind {
+		case DiffAdded:
+			line = addedStyle.Render(fmt.Sprintf("+ %s: %s", change.Path, diffValueString(change.New)))
+		case DiffRemoved:
+			line = removedStyle.Render(fmt.Sprintf("- %s: %s", change.Path, diffValueString(change.Old)))
+		default:
+			line = fmt.Sprintf("%s %s", modifiedStyle.Render("~ "+change.Path+":"),
+				removedStyle.Render(diffValueString(change.Old))+" → "+addedStyle.Render(diffValueString(change.New)))
+		}
+		fmt.Fprintln(w, line)
+	}
+}
+
+func diffValueString(value any) string {
+	out, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(out)
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package display
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/maxatome/go-testdeep/td"
+)
+
+func TestComputeDiff(t *testing.T) {
+	before := map[string]any{
+		"displayName": "old",
+		"keymap":      "fr",
+		"size":        json.Number("10"),
+		"network":     map[string]any{"public": true, "vlan": json.Number("1")},
+		"tags":        []any{"a", "b"},
+		"routes":      []any{map[string]any{"nextHop": "10.0.0.1"}},
+	}
+	after := map[string]any{
+		"displayName": "new",
+		"size":        float64(10),
+		"network":     map[string]any{"public": false, "vlan": json.Number("1")},
+		"tags":        []any{"a", "b", "c"},
+		"routes":      []any{map[string]any{"nextHop": "10.0.0.2"}},
+		"netbootMode": "rescue",
+	}
+
+	changes := ComputeDiff(before, after)
+	td.Cmp(t, changes, []DiffChange{
+		{Kind: DiffModified, Path: "displayName", Old: "old", New: "new"},
+		{Kind: DiffRemoved, Path: "keymap", Old: "fr"},
+		{Kind: DiffAdded, Path: "netbootMode", New: "rescue"},
+		{Kind: DiffModified, Path: "network.public", Old: true, New: false},
+		{Kind: DiffModified, Path: "routes[0].nextHop", Old: "10.0.0.1", New: "10.0.0.2"},
+		{Kind: DiffModified, Path: "tags", Old: []any{"a", "b"}, New: []any{"a", "b", "c"}},
+	})
+
+	td.CmpEmpty(t, ComputeDiff(before, before))
+
+	var out bytes.Buffer
+	RenderDiff(&out, changes)
+	td.Cmp(t, out.String(), `~ displayName: "old" → "new"
+- keymap: "fr"
++ netbootMode: "rescue"
+~ network.public: true → false
+~ routes[0].nextHop: "10.0.0.1" → "10.0.0.2"
+~ tags: ["a","b"] → ["a","b","c"]
+`)
+}
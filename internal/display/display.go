@@ -43,14 +43,9 @@ func renderCustomFormat(value any, format string) error {
 	case reflect.Slice:
 		var output strings.Builder
 		for _, val := range value.([]map[string]any) {
-			out, err := ev(context.Background(), val)
+			outBytes, err := evalCustomFormat(ev, val)
 			if err != nil {
-				return fmt.Errorf("couldn't extract data according to given format: %w", err)
-			}
-
-			outBytes, err := json.Marshal(out)
-			if err != nil {
-				return fmt.Errorf("error marshalling result: %w", err)
+				return err
 			}
 			output.Write(outBytes)
 			output.WriteString("\n")
@@ -58,23 +53,83 @@ func renderCustomFormat(value any, format string) error {
 		ResultString = output.String()
 		fmt.Print(ResultString)
 	default:
-		out, err := ev(context.Background(), value)
+		outBytes, err := evalCustomFormat(ev, value)
+		if err != nil {
+			return err
+		}
+		ResultString = string(outBytes)
+		fmt.Print(string(outBytes))
+	}
+
+	return nil
+}
+
+// evalCustomFormat returns the JSON encoding of the given value formatted with the given expression
+func evalCustomFormat(ev gval.Evaluable, value any) ([]byte, error) {
+	out, err := ev(context.Background(), value)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't extract data according to given format: %w", err)
+	}
+
+	outBytes, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling result: %w", err)
+	}
+
+	return outBytes, nil
+}
+
+// rowEvaluator caches the expression used to render rows with RenderRow
+var rowEvaluator struct {
+	format string
+	ev     gval.Evaluable
+}
+
+// RenderRow renders a single item of a list, when the output format is streamable.
+func RenderRow(value map[string]any, outputFormat *OutputFormat) error {
+	if len(outputFormat.Columns) > 0 {
+		rows, err := selectColumns([]map[string]any{value}, outputFormat.Columns)
 		if err != nil {
-			return fmt.Errorf("couldn't extract data according to given format: %w", err)
+			return err
 		}
+		value = rows[0]
+	}
 
-		outBytes, err := json.Marshal(out)
+	if outputFormat.IsNdjson() {
+		outBytes, err := json.Marshal(value)
 		if err != nil {
 			return fmt.Errorf("error marshalling result: %w", err)
 		}
-		ResultString = string(outBytes)
-		fmt.Print(string(outBytes))
+		ResultString += string(outBytes) + "\n"
+		fmt.Println(string(outBytes))
+
+		return nil
+	}
+
+	if format := outputFormat.CustomFormat(); rowEvaluator.format != format {
+		ev, err := gval.Full(filters.AdditionalEvaluators...).NewEvaluable(format)
+		if err != nil {
+			return fmt.Errorf("invalid format given: %w", err)
+		}
+		rowEvaluator.format, rowEvaluator.ev = format, ev
 	}
 
+	outBytes, err := evalCustomFormat(rowEvaluator.ev, value)
+	if err != nil {
+		return err
+	}
+	ResultString += string(outBytes) + "\n"
+	fmt.Println(string(outBytes))
+
 	return nil
 }
 
 func RenderTable(values []map[string]any, columnsToDisplay []string, outputFormat *OutputFormat) {
+	values, columnsToDisplay, err := prepareList(values, columnsToDisplay, outputFormat)
+	if err != nil {
+		exitError("%s", err)
+	}
+
 	switch {
 	case outputFormat.CustomFormat() != "":
 		if err := renderCustomFormat(values, outputFormat.CustomFormat()); err != nil {
@@ -94,32 +149,34 @@ func RenderTable(values []map[string]any, columnsToDisplay []string, outputForma
 			exitError("error displaying JSON results: %s", err)
 		}
 		return
-	}
-
-	var (
-		rows      [][]string
-		selectors gval.Evaluables
-	)
-
-	columnsTitles := make([]string, 0, len(columnsToDisplay))
-	for _, col := range columnsToDisplay {
-		// If column to display contains an alias, use it as column title
-		colName, alias, ok := strings.Cut(col, " ")
-		if ok {
-			col = colName
-			columnsTitles = append(columnsTitles, alias)
-		} else {
-			columnsTitles = append(columnsTitles, col)
+	case outputFormat.IsNdjson():
+		if err := printNDJSON(values...); err != nil {
+			exitError("error displaying NDJSON results: %s", err)
 		}
-
-		// Create selector to extract value at given key
-		evaluator, err := gval.Base().NewEvaluable(col)
-		if err != nil {
-			exitError("invalid column to display %q: %s", col, err)
+		return
+	case outputFormat.IsTemplate():
+		if err := renderUserTemplate(values, "", outputFormat); err != nil {
+			exitError("error rendering template: %s", err)
 		}
-		selectors = append(selectors, evaluator)
+		return
+	case outputFormat.IsCsv(), outputFormat.IsTsv():
+		separator := ','
+		if outputFormat.IsTsv() {
+			separator = '\t'
+		}
+		if err := renderDelimited(values, columnsToDisplay, separator); err != nil {
+			exitError("error displaying %s results: %s", strings.ToUpper(outputFormat.Output), err)
+		}
+		return
+	}
+
+	columnsTitles, selectors, err := parseColumns(columnsToDisplay)
+	if err != nil {
+		exitError("%s", err)
 	}
 
+	var rows [][]string
+
 	// Extract values to display
 	for _, value := range values {
 		var row []string
@@ -176,10 +233,12 @@ func RenderTable(values []map[string]any, columnsToDisplay []string, outputForma
 	outputf("%s%s", t, "\n💡 Use option -o json or -o yaml to get the raw output with all information")
 }
 
-func RenderConfigTable(cfg *ini.File) {
+// RenderConfigTable displays the keys of the given configuration, with their
+// effective value and source as returned by the given function.
+func RenderConfigTable(cfg *ini.File, effectiveValue func(section, key string) (string, string)) {
 	var (
 		rows    [][]string
-		columns = []string{"section", "key", "value"}
+		columns = []string{"section", "key", "value", "source"}
 	)
 
 	for _, section := range cfg.Sections() {
@@ -189,8 +248,9 @@ func RenderConfigTable(cfg *ini.File) {
 
 		rows = append(rows, []string{section.Name()})
 		for _, key := range section.Keys() {
-			value := ansi.Truncate(key.Value(), maxCellWidth, "…")
-			rows = append(rows, []string{"", key.Name(), value})
+			value, source := effectiveValue(section.Name(), key.Name())
+			value = ansi.Truncate(value, maxCellWidth, "…")
+			rows = append(rows, []string{"", key.Name(), value, source})
 		}
 	}
 
@@ -235,6 +295,24 @@ func prettyPrintJSON(value any) error {
 	return nil
 }
 
+// printNDJSON prints each of the given values as a compact JSON document on its own line
+func printNDJSON[T any](values ...T) error {
+	var output strings.Builder
+	for _, value := range values {
+		bytesOut, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		output.Write(bytesOut)
+		output.WriteString("\n")
+	}
+
+	ResultString = output.String()
+	fmt.Print(ResultString)
+
+	return nil
+}
+
 func prettyPrintYAML(value any) error {
 	bytesOut, err := yaml.Marshal(value)
 	if err != nil {
@@ -248,7 +326,7 @@ func prettyPrintYAML(value any) error {
 
 func OutputObject(value map[string]any, serviceName, templateContent string, outputFormat *OutputFormat) {
 	// Force JSON rendering if no template defined
-	if templateContent == "" && !outputFormat.IsYaml() &&
+	if templateContent == "" && !outputFormat.IsYaml() && !outputFormat.IsNdjson() && !outputFormat.IsTemplate() &&
 		!outputFormat.IsInteractive() && outputFormat.CustomFormat() == "" {
 		outputFormat.Output = "json"
 	}
@@ -269,13 +347,26 @@ func OutputObject(value map[string]any, serviceName, templateContent string, out
 			exitError("error displaying JSON results: %s", err)
 		}
 		return
+	case outputFormat.IsNdjson():
+		if err := printNDJSON(value); err != nil {
+			exitError("error displaying NDJSON results: %s", err)
+		}
+		return
 	case outputFormat.IsInteractive():
 		displayInteractive(value)
 		return
+	case outputFormat.IsTemplate():
+		if err := renderUserTemplate(value, serviceName, outputFormat); err != nil {
+			exitError("error rendering template: %s", err)
+		}
+		return
 	default:
 		var tpl bytes.Buffer
-		t := template.Must(template.New("").Funcs(funcMap).Parse(templateContent))
-		err := t.Execute(&tpl, map[string]any{
+		t, err := template.New("").Funcs(funcMap).Parse(templateContent)
+		if err != nil {
+			exitError("failed to parse template: %s", err)
+		}
+		err = t.Execute(&tpl, map[string]any{
 			"ServiceName": serviceName,
 			"Result":      value,
 		})
@@ -322,7 +413,7 @@ func exitError(message string, params ...any) {
 	resultString := fmt.Sprintf("🛑 "+message, params...)
 	fmt.Println(resultString)
 	ResultError = errors.New(resultString)
-	os.Exit(1)
+	Exit(1)
 }
 
 func outputf(message string, params ...any) {
@@ -356,6 +447,11 @@ func OutputWithFormat(msg *OutputMessage, outputFormat *OutputFormat) {
 			exitError(err.Error())
 		}
 
+	case outputFormat.IsNdjson():
+		if err := printNDJSON(msg); err != nil {
+			exitError(err.Error())
+		}
+
 	case outputFormat.IsInteractive():
 		displayInteractive(msg)
 
@@ -363,12 +459,15 @@ func OutputWithFormat(msg *OutputMessage, outputFormat *OutputFormat) {
 		outputf("%s", msg.Message)
 	}
 
-	if msg.Error {
+	if msg.Interrupted {
+		ResultError = errors.New(msg.Message)
+		Exit(InterruptedExitCode)
+	} else if msg.Error {
 		ResultError = errors.New(msg.Message)
-		os.Exit(1)
+		Exit(1)
 	} else if msg.Warning {
 		ResultError = errors.New(msg.Message)
-		os.Exit(0)
+		Exit(0)
 	}
 }
 
@@ -379,11 +478,34 @@ func OutputInfo(outputFormat *OutputFormat, details any, message string, params
 	}, outputFormat)
 }
 
+// OutputError displays the given error message and exits. When the command
+// has been interrupted, the error is reported as an interruption.
 func OutputError(outputFormat *OutputFormat, message string, params ...any) {
 	resultString := fmt.Sprintf("🛑 "+message, params...)
 	OutputWithFormat(&OutputMessage{
-		Message: resultString,
-		Error:   true,
+		Message:     resultString,
+		Error:       true,
+		Interrupted: Interrupted.Load(),
+	}, outputFormat)
+}
+
+// OutputInterrupted displays the given message explaining what was done before
+// the command was interrupted, and exits with InterruptedExitCode. When an output
+// format is given, the message is written to stderr to keep the partial results
+// already displayed on stdout a valid document.
+func OutputInterrupted(outputFormat *OutputFormat, message string, params ...any) {
+	resultString := fmt.Sprintf("⛔ "+message, params...)
+	if outputFormat.Output != "" {
+		fmt.Fprintln(os.Stderr, resultString)
+		ResultError = errors.New(resultString)
+		Exit(InterruptedExitCode)
+		return
+	}
+
+	OutputWithFormat(&OutputMessage{
+		Message:     resultString,
+		Error:       true,
+		Interrupted: true,
 	}, outputFormat)
 }
 
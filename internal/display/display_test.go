@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package display
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/maxatome/go-testdeep/td"
+)
+
+func TestOutputInterruptedStructuredFormat(t *testing.T) {
+	var code int
+	Exit = func(c int) { code = c }
+	t.Cleanup(func() { Exit = os.Exit })
+
+	stdoutR, stdoutW, err := os.Pipe()
+	td.Require(t).CmpNoError(err)
+	stderrR, stderrW, err := os.Pipe()
+	td.Require(t).CmpNoError(err)
+
+	stdout, stderr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = stdoutW, stderrW
+	OutputInterrupted(&OutputFormat{Output: "json"}, "interrupted after displaying %d results", 2)
+	os.Stdout, os.Stderr = stdout, stderr
+	stdoutW.Close()
+	stderrW.Close()
+
+	out, _ := io.ReadAll(stdoutR)
+	errOut, _ := io.ReadAll(stderrR)
+	td.Cmp(t, code, InterruptedExitCode)
+	td.Cmp(t, string(out), "")
+	td.Cmp(t, string(errOut), "⛔ interrupted after displaying 2 results\n")
+	td.CmpString(t, ResultError, "⛔ interrupted after displaying 2 results")
+}
@@ -57,7 +57,52 @@ func renderCustomFormat(value any, format string) {
 	}
 }
 
+// RenderRow renders a single item of a list, when the output format is streamable.
+func RenderRow(value map[string]any, outputFormat *OutputFormat) error {
+	if len(outputFormat.Columns) > 0 {
+		rows, err := selectColumns([]map[string]any{value}, outputFormat.Columns)
+		if err != nil {
+			return err
+		}
+		value = rows[0]
+	}
+
+	if outputFormat.IsNdjson() {
+		outBytes, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("error marshalling result: %w", err)
+		}
+		ResultString += string(outBytes) + "\n"
+
+		return nil
+	}
+
+	ev, err := gval.Full(filters.AdditionalEvaluators...).NewEvaluable(outputFormat.CustomFormat())
+	if err != nil {
+		return fmt.Errorf("invalid format given: %w", err)
+	}
+
+	out, err := ev(context.Background(), value)
+	if err != nil {
+		return fmt.Errorf("couldn't extract data according to given format: %w", err)
+	}
+
+	outBytes, err := json.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("error marshalling result: %w", err)
+	}
+	ResultString += string(outBytes) + "\n"
+
+	return nil
+}
+
 func RenderTable(values []map[string]any, columnsToDisplay []string, outputFormat *OutputFormat) {
+	values, columnsToDisplay, err := prepareList(values, columnsToDisplay, outputFormat)
+	if err != nil {
+		exitError("%s", err)
+		return
+	}
+
 	if outputFormat.CustomFormat() != "" {
 		renderCustomFormat(values, outputFormat.CustomFormat())
 		return
@@ -112,7 +157,7 @@ func RenderTable(values []map[string]any, columnsToDisplay []string, outputForma
 	}
 }
 
-func RenderConfigTable(cfg *ini.File) {
+func RenderConfigTable(cfg *ini.File, _ func(section, key string) (string, string)) {
 	// TODO: untested
 	output := map[string]any{}
 	if err := cfg.MapTo(&output); err != nil {
@@ -172,6 +217,10 @@ func OutputError(outputFormat *OutputFormat, message string, params ...any) {
 	exitError(message, params...)
 }
 
+func OutputInterrupted(outputFormat *OutputFormat, message string, params ...any) {
+	exitError(message, params...)
+}
+
 func OutputWarning(outputFormat *OutputFormat, message string, params ...any) {
 	exitError(message, params...)
 }
@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package display
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/PaesslerAG/gval"
+	"github.com/ovh/ovhcloud-cli/internal/filters"
+)
+
+// prepareList sorts the given values according to the --sort-by and --desc flags,
+// and keeps the first --limit ones. It returns the values with the columns to
+// display, that are replaced by the ones given with --columns or defined in the
+// configuration, if any. With --columns, the values are also reduced to the given
+// columns for the output formats that do not render columns (JSON, YAML…).
+func prepareList(values []map[string]any, columnsToDisplay []string, outputFormat *OutputFormat) ([]map[string]any, []string, error) {
+	if outputFormat.SortBy != "" {
+		sorted, err := sortValues(values, outputFormat.SortBy, outputFormat.Desc)
+		if err != nil {
+			return nil, nil, err
+		}
+		values = sorted
+	}
+
+	if outputFormat.Limit > 0 && len(values) > outputFormat.Limit {
+		values = values[:outputFormat.Limit]
+	}
+
+	if len(outputFormat.Columns) == 0 {
+		if len(outputFormat.DefaultColumns) > 0 {
+			columnsToDisplay = outputFormat.DefaultColumns
+		}
+		return values, columnsToDisplay, nil
+	}
+	columnsToDisplay = outputFormat.Columns
+
+	if outputFormat.Output != "" && !outputFormat.IsCsv() && !outputFormat.IsTsv() {
+		selected, err := selectColumns(values, columnsToDisplay)
+		if err != nil {
+			return nil, nil, err
+		}
+		values = selected
+	}
+
+	return values, columnsToDisplay, nil
+}
+
+// selectColumns returns, for each of the given values, an object containing
+// the given columns, indexed by their title.
+func selectColumns(values []map[string]any, columns []string) ([]map[string]any, error) {
+	titles, selectors, err := parseColumns(columns)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]map[string]any, 0, len(values))
+	for _, value := range values {
+		row := make(map[string]any, len(selectors))
+		for i, selector := range selectors {
+			val, err := selector(context.Background(), value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to select row field: %w", err)
+			}
+			row[titles[i]] = val
+		}
+		selected = append(selected, row)
+	}
+
+	return selected, nil
+}
+
+// sortValues returns the given values sorted by the result of the given gval expression.
+// Values for which the expression returns nothing are placed first (or last if desc).
+func sortValues(values []map[string]any, sortBy string, desc bool) ([]map[string]any, error) {
+	ev, err := gval.Full(filters.AdditionalEvaluators...).NewEvaluable(sortBy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sort expression %q: %w", sortBy, err)
+	}
+
+	type sortedValue struct {
+		key   any
+		value map[string]any
+	}
+
+	sorted := make([]sortedValue, 0, len(values))
+	for _, value := range values {
+		key, err := ev(context.Background(), value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate sort expression: %w", err)
+		}
+		sorted = append(sorted, sortedValue{key: key, value: value})
+	}
+
+	slices.SortStableFunc(sorted, func(a, b sortedValue) int {
+		if desc {
+			return compareValues(b.key, a.key)
+		}
+		return compareValues(a.key, b.key)
+	})
+
+	result := make([]map[string]any, 0, len(sorted))
+	for _, item := range sorted {
+		result = append(result, item.value)
+	}
+
+	return result, nil
+}
+
+// compareValues compares two values returned by the API. Numbers, strings and
+// booleans are compared by value, other values by their textual representation.
+func compareValues(a, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+
+	if numA, ok := toFloat(a); ok {
+		if numB, ok := toFloat(b); ok {
+			return cmp.Compare(numA, numB)
+		}
+	}
+
+	switch valA := a.(type) {
+	case string:
+		if valB, ok := b.(string); ok {
+			return cmp.Compare(valA, valB)
+		}
+	case bool:
+		if valB, ok := b.(bool); ok {
+			switch {
+			case valA == valB:
+				return 0
+			case valA:
+				return 1
+			default:
+				return -1
+			}
+		}
+	}
+
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// toFloat converts the given numeric value to a float64
+func toFloat(value any) (float64, bool) {
+	switch val := value.(type) {
+	case json.Number:
+		f, err := val.Float64()
+		return f, err == nil
+	case float64:
+		return val, true
+	case float32:
+		return float64(val), true
+	case int:
+		return float64(val), true
+	case int64:
+		return float64(val), true
+	}
+
+	return 0, false
+}
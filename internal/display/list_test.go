@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package display
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/maxatome/go-testdeep/td"
+)
+
+func TestSortValues(t *testing.T) {
+	values := []map[string]any{
+		{"name": "b", "size": json.Number("10")},
+		{"name": "c"},
+		{"name": "a", "size": json.Number("9")},
+		{"name": "d", "size": json.Number("10")},
+	}
+
+	names := func(values []map[string]any) []any {
+		var result []any
+		for _, value := range values {
+			result = append(result, value["name"])
+		}
+		return result
+	}
+
+	// Numbers are compared by value, missing values come first and the sort is stable
+	sorted, err := sortValues(values, "size", false)
+	td.CmpNoError(t, err)
+	td.Cmp(t, names(sorted), []any{"c", "a", "b", "d"})
+
+	sorted, err = sortValues(values, "size", true)
+	td.CmpNoError(t, err)
+	td.Cmp(t, names(sorted), []any{"b", "d", "a", "c"})
+
+	sorted, err = sortValues(values, `name == "c" ? "0" : name`, false)
+	td.CmpNoError(t, err)
+	td.Cmp(t, names(sorted), []any{"c", "a", "b", "d"})
+
+	_, err = sortValues(values, "size +", false)
+	td.Cmp(t, err, td.HasPrefix(`invalid sort expression "size +"`))
+}
+
+func TestPrepareList(t *testing.T) {
+	values := []map[string]any{
+		{"id": "1", "name": "first", "nested": map[string]any{"field": "x"}},
+		{"id": "2", "name": "second", "nested": map[string]any{"field": "y"}},
+	}
+
+	// In table mode, only the columns to display are replaced
+	result, columns, err := prepareList(values, []string{"id"}, &OutputFormat{Columns: []string{"nested.field field"}, Limit: 1})
+	td.CmpNoError(t, err)
+	td.Cmp(t, result, values[:1])
+	td.Cmp(t, columns, []string{"nested.field field"})
+
+	// Other formats only get the selected columns
+	result, _, err = prepareList(values, []string{"id"}, &OutputFormat{Output: "json", Columns: []string{"name", "nested.field field"}})
+	td.CmpNoError(t, err)
+	td.Cmp(t, result, []map[string]any{
+		{"name": "first", "field": "x"},
+		{"name": "second", "field": "y"},
+	})
+
+	// Default columns from the configuration don't reduce the values
+	result, columns, err = prepareList(values, []string{"id"}, &OutputFormat{Output: "json", DefaultColumns: []string{"name"}})
+	td.CmpNoError(t, err)
+	td.Cmp(t, result, values)
+	td.Cmp(t, columns, []string{"name"})
+}
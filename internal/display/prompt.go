@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !(js && wasm)
+
+package display
+
+import (
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxPromptCandidates is the maximum number of completion candidates displayed under the prompt
+const maxPromptCandidates = 30
+
+type promptModel struct {
+	input textinput.Model
+
+	// history of the previous lines, browsed using the up and down keys
+	history      []string
+	historyIndex int
+	draft        string
+
+	complete   func(line string) []string
+	candidates []string
+
+	done bool
+	eof  bool
+}
+
+func (m promptModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m *promptModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		m.candidates = nil
+
+		switch msg.String() {
+		case "enter":
+			m.done = true
+			return m, tea.Quit
+
+		case "ctrl+c":
+			// Abandon the current line
+			m.input.SetValue("")
+			m.done = true
+			return m, tea.Quit
+
+		case "ctrl+d":
+			if m.input.Value() == "" {
+				m.eof = true
+				return m, tea.Quit
+			}
+
+		case "up":
+			if m.historyIndex > 0 {
+				if m.historyIndex == len(m.history) {
+					m.draft = m.input.Value()
+				}
+				m.historyIndex--
+				m.input.SetValue(m.history[m.historyIndex])
+				m.input.CursorEnd()
+			}
+			return m, nil
+
+		case "down":
+			if m.historyIndex < len(m.history) {
+				m.historyIndex++
+				if m.historyIndex == len(m.history) {
+					m.input.SetValue(m.draft)
+				} else {
+					m.input.SetValue(m.history[m.historyIndex])
+				}
+				m.input.CursorEnd()
+			}
+			return m, nil
+
+		case "tab":
+			m.completeLine()
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+
+	return m, cmd
+}
+
+// completeLine completes the last word of the line with the candidates returned
+// by the completion function, or with their common prefix when there are several
+func (m *promptModel) completeLine() {
+	if m.complete == nil {
+		return
+	}
+
+	line := m.input.Value()[:m.input.Position()]
+	candidates := m.complete(line)
+	if len(candidates) == 0 {
+		return
+	}
+
+	lastWordStart := strings.LastIndexAny(line, " \t") + 1
+	lastWord := line[lastWordStart:]
+
+	completion := candidates[0] + " "
+	if len(candidates) > 1 {
+		completion = commonPrefix(candidates)
+		if len(completion) <= len(lastWord) {
+			m.candidates = candidates
+			return
+		}
+	}
+
+	rest := m.input.Value()[m.input.Position():]
+	m.input.SetValue(line[:lastWordStart] + completion + rest)
+	m.input.SetCursor(lastWordStart + len(completion))
+}
+
+func (m promptModel) View() string {
+	if m.done || m.eof {
+		return m.input.Prompt + m.input.Value() + "\n"
+	}
+
+	if len(m.candidates) == 0 {
+		return m.input.View()
+	}
+
+	candidates := m.candidates
+	if len(candidates) > maxPromptCandidates {
+		candidates = append(candidates[:maxPromptCandidates:maxPromptCandidates], "…")
+	}
+
+	return m.input.View() + "\n" + helpStyle.Render(strings.Join(candidates, "  "))
+}
+
+func commonPrefix(values []string) string {
+	prefix := values[0]
+	for _, value := range values[1:] {
+		for !strings.HasPrefix(value, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+// RunPrompt reads a line on the terminal, with the given history of previous lines
+// and the given function returning the completion candidates of the last word of a line.
+// It returns io.EOF when the user closes the input (Ctrl-D on an empty line).
+func RunPrompt(prompt string, history []string, complete func(line string) []string) (string, error) {
+	input := textinput.New()
+	input.Prompt = prompt
+	input.Focus()
+
+	model := &promptModel{
+		input:        input,
+		history:      history,
+		historyIndex: len(history),
+		complete:     complete,
+	}
+
+	if _, err := tea.NewProgram(model).Run(); err != nil {
+		return "", err
+	}
+
+	if model.eof {
+		return "", io.EOF
+	}
+
+	return model.input.Value(), nil
+}
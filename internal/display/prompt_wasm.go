@@ -0,0 +1,13 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build js && wasm
+
+package display
+
+import "errors"
+
+func RunPrompt(_ string, _ []string, _ func(line string) []string) (string, error) {
+	return "", errors.New("prompt is not available in WASM mode")
+}
@@ -5,10 +5,16 @@
 package display
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"math"
+	"reflect"
 	"strings"
+	"text/tabwriter"
 	"text/template"
+	"time"
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -33,6 +39,12 @@ var (
 		"toTitle":        cases.Title(language.English).String,
 		"toUpper":        strings.ToUpper,
 		"toLower":        strings.ToLower,
+		"formatDate":     formatDate,
+		"toJson":         toJSON,
+		"default":        defaultValue,
+		"indent":         indent,
+		"table":          textTable,
+		"markdownTable":  markdownTable,
 	}
 
 	// unit holds the units for formatting bytes
@@ -63,3 +75,184 @@ func formatByteSize(bytes float64, decimals int, format int) string {
 
 	return fmt.Sprintf("%.*f %s", decimals, value, unitList[i])
 }
+
+// renderUserTemplate renders the given value (an object or a list) with the template
+// given by the user with -o template=<template> or -o template-file=<path>.
+func renderUserTemplate(value any, serviceName string, outputFormat *OutputFormat) error {
+	content, err := outputFormat.Template()
+	if err != nil {
+		return err
+	}
+
+	t, err := template.New("output").Funcs(funcMap).Parse(content)
+	if err != nil {
+		return fmt.Errorf("invalid template: %w", err)
+	}
+
+	var output bytes.Buffer
+	if err := t.Execute(&output, map[string]any{
+		"ServiceName": serviceName,
+		"Result":      value,
+	}); err != nil {
+		return err
+	}
+
+	ResultString = output.String()
+	fmt.Print(ResultString)
+
+	return nil
+}
+
+// dateLayouts are the layouts used to parse the dates returned by the API
+var dateLayouts = []string{time.RFC3339Nano, time.DateTime, time.DateOnly}
+
+// formatDate formats the given date (a string returned by the API, a time or
+// a UNIX timestamp) using the given Go layout, e.g. "2006-01-02 15:04".
+// Values that are not dates are returned unchanged.
+func formatDate(layout string, value any) any {
+	switch val := value.(type) {
+	case time.Time:
+		return val.Format(layout)
+	case json.Number:
+		if timestamp, err := val.Int64(); err == nil {
+			return time.Unix(timestamp, 0).Format(layout)
+		}
+	case string:
+		for _, dateLayout := range dateLayouts {
+			if date, err := time.Parse(dateLayout, val); err == nil {
+				return date.Format(layout)
+			}
+		}
+	}
+
+	return value
+}
+
+// toJSON returns the compact JSON encoding of the given value
+func toJSON(value any) (string, error) {
+	out, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
+
+// defaultValue returns the given value, or the default one if it is empty
+// (nil, zero, empty string, list or object), e.g. {{ .Result.name | default "-" }}
+func defaultValue(defaultVal, value any) any {
+	if value == nil {
+		return defaultVal
+	}
+
+	if val := reflect.ValueOf(value); val.IsZero() ||
+		(val.Kind() == reflect.Slice || val.Kind() == reflect.Map) && val.Len() == 0 {
+		return defaultVal
+	}
+
+	return value
+}
+
+// indent prefixes each line of the given text with the given number of spaces
+func indent(spaces int, text string) string {
+	padding := strings.Repeat(" ", spaces)
+
+	return padding + strings.ReplaceAll(text, "\n", "\n"+padding)
+}
+
+// tableRows returns the titles of the given columns (using the same syntax as the
+// columns of list commands) and their values for each of the given objects.
+func tableRows(values any, columns []string) ([]string, [][]string, error) {
+	var objects []map[string]any
+	switch val := values.(type) {
+	case []map[string]any:
+		objects = val
+	case []any:
+		for _, item := range val {
+			object, ok := item.(map[string]any)
+			if !ok {
+				return nil, nil, fmt.Errorf("value %v is not an object", item)
+			}
+			objects = append(objects, object)
+		}
+	default:
+		return nil, nil, fmt.Errorf("value of type %T is not a list of objects", values)
+	}
+
+	titles, selectors, err := parseColumns(columns)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([][]string, 0, len(objects))
+	for _, object := range objects {
+		row := make([]string, 0, len(selectors))
+		for _, selector := range selectors {
+			val, err := selector(context.Background(), object)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to select row field: %w", err)
+			}
+
+			cell, err := delimitedCell(val)
+			if err != nil {
+				return nil, nil, err
+			}
+			row = append(row, cell)
+		}
+		rows = append(rows, row)
+	}
+
+	return titles, rows, nil
+}
+
+// textTable renders the given columns of a list of objects as a plain text table
+// with aligned columns, e.g. {{ table .Result "id" "name" "flavor.name flavor" }}
+func textTable(values any, columns ...string) (string, error) {
+	titles, rows, err := tableRows(values, columns)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		output bytes.Buffer
+		writer = tabwriter.NewWriter(&output, 0, 0, 2, ' ', 0)
+	)
+
+	fmt.Fprintln(writer, strings.Join(titles, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+	if err := writer.Flush(); err != nil {
+		return "", err
+	}
+
+	return output.String(), nil
+}
+
+// markdownTable renders the given columns of a list of objects as a Markdown table,
+// e.g. {{ markdownTable .Result.ipAddresses "ip" "type" "version" }}
+func markdownTable(values any, columns ...string) (string, error) {
+	titles, rows, err := tableRows(values, columns)
+	if err != nil {
+		return "", err
+	}
+
+	escape := strings.NewReplacer("|", "\\|", "\n", " ")
+
+	var output strings.Builder
+	writeRow := func(cells []string) {
+		output.WriteString("|")
+		for _, cell := range cells {
+			output.WriteString(" " + escape.Replace(cell) + " |")
+		}
+		output.WriteString("\n")
+	}
+
+	writeRow(titles)
+	output.WriteString("|" + strings.Repeat(" --- |", len(titles)) + "\n")
+	for _, row := range rows {
+		writeRow(row)
+	}
+
+	return output.String(), nil
+}
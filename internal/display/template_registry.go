@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package display
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// embeddedTemplate is a Markdown template embedded in the binary, that can be
+// overridden by a file of the user templates directory
+type embeddedTemplate struct {
+	service string
+	name    string
+	content string
+	target  *string
+}
+
+// embeddedTemplates are the templates registered by all the services
+var embeddedTemplates []embeddedTemplate
+
+// RegisterTemplates registers the given embedded templates of a service, indexed by
+// name. The registered templates can be overridden by the user with a file named
+// <service>/<name>.tmpl in the user templates directory, and exported.
+func RegisterTemplates(service string, templates map[string]*string) {
+	for name, target := range templates {
+		embeddedTemplates = append(embeddedTemplates, embeddedTemplate{
+			service: service,
+			name:    name,
+			content: *target,
+			target:  target,
+		})
+	}
+}
+
+// templatePath returns the path of the given template in the given directory
+func templatePath(dir string, tpl embeddedTemplate) string {
+	return filepath.Join(dir, tpl.service, tpl.name+".tmpl")
+}
+
+// LoadTemplateOverrides replaces the registered templates by the ones found in the
+// given user templates directory, if any. The other templates keep their embedded
+// content.
+func LoadTemplateOverrides(dir string) error {
+	if _, err := os.Stat(dir); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	for _, tpl := range embeddedTemplates {
+		content, err := os.ReadFile(templatePath(dir, tpl))
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			*tpl.target = tpl.content
+		case err != nil:
+			return fmt.Errorf("failed to read template %s/%s: %w", tpl.service, tpl.name, err)
+		default:
+			*tpl.target = string(content)
+		}
+	}
+
+	return nil
+}
+
+// ExportTemplates writes the embedded content of all the registered templates in
+// the given directory, with the layout of the user templates directory. Existing
+// files are only overwritten if force is set. It returns the paths of the written files.
+func ExportTemplates(dir string, force bool) ([]string, error) {
+	templates := slices.Clone(embeddedTemplates)
+	slices.SortFunc(templates, func(a, b embeddedTemplate) int {
+		return strings.Compare(a.service+"/"+a.name, b.service+"/"+b.name)
+	})
+
+	if !force {
+		for _, tpl := range templates {
+			if _, err := os.Stat(templatePath(dir, tpl)); err == nil {
+				return nil, fmt.Errorf("file %s already exists, use --force to overwrite it", templatePath(dir, tpl))
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(templates))
+	for _, tpl := range templates {
+		path := templatePath(dir, tpl)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, []byte(tpl.content), 0644); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
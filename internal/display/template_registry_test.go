@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package display
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maxatome/go-testdeep/td"
+)
+
+func TestTemplateOverrides(t *testing.T) {
+	previousTemplates := embeddedTemplates
+	t.Cleanup(func() { embeddedTemplates = previousTemplates })
+	embeddedTemplates = nil
+
+	first, second := "first embedded", "second embedded"
+	RegisterTemplates("svc", map[string]*string{"first": &first, "second": &second})
+
+	// Missing directory is ignored
+	td.CmpNoError(t, LoadTemplateOverrides(filepath.Join(t.TempDir(), "missing")))
+	td.Cmp(t, first, "first embedded")
+
+	// Export the embedded templates
+	dir := t.TempDir()
+	paths, err := ExportTemplates(dir, false)
+	td.CmpNoError(t, err)
+	td.Cmp(t, paths, []string{filepath.Join(dir, "svc", "first.tmpl"), filepath.Join(dir, "svc", "second.tmpl")})
+
+	content, err := os.ReadFile(paths[1])
+	td.CmpNoError(t, err)
+	td.Cmp(t, string(content), "second embedded")
+
+	// Existing files are not overwritten without force
+	_, err = ExportTemplates(dir, false)
+	td.CmpString(t, err, "file "+paths[0]+" already exists, use --force to overwrite it")
+	_, err = ExportTemplates(dir, true)
+	td.CmpNoError(t, err)
+
+	// Only the templates found in the directory are overridden
+	td.CmpNoError(t, os.WriteFile(paths[0], []byte("first override"), 0644))
+	td.CmpNoError(t, os.Remove(paths[1]))
+	td.CmpNoError(t, LoadTemplateOverrides(dir))
+	td.Cmp(t, first, "first override")
+	td.Cmp(t, second, "second embedded")
+
+	// Exported templates always are the embedded ones
+	td.CmpNoError(t, os.Remove(paths[0]))
+	_, err = ExportTemplates(dir, false)
+	td.CmpNoError(t, err)
+	content, err = os.ReadFile(paths[0])
+	td.CmpNoError(t, err)
+	td.Cmp(t, string(content), "first embedded")
+}
@@ -5,7 +5,11 @@
 package display
 
 import (
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/maxatome/go-testdeep/td"
 )
@@ -60,3 +64,60 @@ func TestFormatBytes_LargeBytes(t *testing.T) {
 	got := formatByteSize(1e25, 2, 1000)
 	td.Cmp(t, got, "10.00 YB")
 }
+
+func TestFormatDate(t *testing.T) {
+	td.Cmp(t, formatDate("2006-01-02 15:04", "2025-03-14T09:26:53+01:00"), "2025-03-14 09:26")
+	td.Cmp(t, formatDate("02/01/2006", "2025-03-14"), "14/03/2025")
+	td.Cmp(t, formatDate(time.DateOnly, json.Number("0")), time.Unix(0, 0).Format(time.DateOnly))
+	td.Cmp(t, formatDate(time.DateOnly, "not a date"), "not a date")
+}
+
+func TestDefaultValue(t *testing.T) {
+	td.Cmp(t, defaultValue("-", nil), "-")
+	td.Cmp(t, defaultValue("-", ""), "-")
+	td.Cmp(t, defaultValue("-", []any{}), "-")
+	td.Cmp(t, defaultValue("-", "value"), "value")
+	td.Cmp(t, defaultValue("-", false), "-")
+}
+
+func TestIndent(t *testing.T) {
+	td.Cmp(t, indent(2, "a\nb"), "  a\n  b")
+}
+
+func TestTables(t *testing.T) {
+	values := []any{
+		map[string]any{"id": "1", "name": "first", "flavor": map[string]any{"name": "b3-8"}},
+		map[string]any{"id": "22", "name": "second | third", "flavor": map[string]any{}},
+	}
+
+	table, err := textTable(values, "id", "name", "flavor.name flavor")
+	td.CmpNoError(t, err)
+	td.Cmp(t, table, "id  name            flavor\n1   first           b3-8\n22  second | third  \n")
+
+	table, err = markdownTable(values, "id", "name")
+	td.CmpNoError(t, err)
+	td.Cmp(t, table, "| id | name |\n| --- | --- |\n| 1 | first |\n| 22 | second \\| third |\n")
+
+	_, err = textTable("value", "id")
+	td.CmpString(t, err, "value of type string is not a list of objects")
+}
+
+func TestRenderUserTemplate(t *testing.T) {
+	value := map[string]any{"name": "vps-1", "ips": []any{"1.2.3.4"}}
+
+	td.CmpNoError(t, renderUserTemplate(value, "vps-1", &OutputFormat{
+		Output: `template={{ .ServiceName }}: {{ toJson .Result.ips }} {{ .Result.state | default "unknown" }}`,
+	}))
+	td.Cmp(t, ResultString, `vps-1: ["1.2.3.4"] unknown`)
+
+	path := filepath.Join(t.TempDir(), "output.tmpl")
+	td.CmpNoError(t, os.WriteFile(path, []byte("{{ range .Result }}{{ .name }}\n{{ end }}"), 0600))
+
+	td.CmpNoError(t, renderUserTemplate([]map[string]any{value, {"name": "vps-2"}}, "", &OutputFormat{
+		Output: "template-file=" + path,
+	}))
+	td.Cmp(t, ResultString, "vps-1\nvps-2\n")
+
+	td.Cmp(t, renderUserTemplate(value, "", &OutputFormat{Output: "template={{ .Result"}),
+		td.HasPrefix("invalid template: "))
+}
@@ -5,6 +5,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/ovh/ovhcloud-cli/internal/display"
 	"gopkg.in/ini.v1"
 )
@@ -17,6 +19,26 @@ var (
 	// Flag used to ignore errors in API calls
 	IgnoreErrors bool
 
+	// Flag used to print the API calls modifying resources instead of sending them
+	DryRun bool
+
+	// Flag used to send request bodies without validating them against the API schemas
+	SkipValidation bool
+
+	// Flags used to record the API calls in a cassette file, or to replay the
+	// calls recorded in a cassette file instead of calling the API
+	RecordFile string
+	ReplayFile string
+
+	// Maximum number of retries of an API call failing with a transient error
+	MaxRetries int
+
+	// Number of concurrent requests made to fetch the items of a list
+	Parallel int
+
+	// Maximum number of requests per second sent to the API (0 for no limit)
+	RateLimit float64
+
 	// Common flags used by all subcommands to control output format (json, yaml)
 	OutputFormatConfig display.OutputFormat
 
@@ -27,13 +49,34 @@ var (
 	// wait for task completion before exiting
 	WaitForTask bool
 
-	// INI configuration file and its path
+	// Flags used to override the default timeout and poll interval when waiting for a task
+	WaitTimeout  time.Duration
+	PollInterval time.Duration
+
+	// Flags used by the wait commands to define the awaited condition and
+	// the condition that stops the wait in error
+	WaitCondition        string
+	WaitFailureCondition string
+
+	// INI configuration merged from all configuration files, and
+	// the path of the configuration file having the highest priority
 	CliConfig     *ini.File
 	CliConfigPath string
 
+	// Flag used to select the configuration file (system, user or local) to write to
+	ConfigScope string
+
 	// Flag to indicate whether the command should use the editor for input parameters
 	ParametersViaEditor bool
 
 	// Flag to indicate whether the command should use a file for input parameters
 	ParametersFile string
+
+	// Flags used by the edit commands to display the changes before applying
+	// them, and to apply them without asking for confirmation
+	ShowDiff  bool
+	AssumeYes bool
+
+	// Flag used to select the configuration profile to use
+	Profile string
 )
@@ -0,0 +1,337 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// redactedValue replaces the credentials and secrets found in the recorded interactions
+const redactedValue = "[REDACTED]"
+
+// sensitiveHeaders are the headers carrying credentials or request signatures
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Ovh-Application",
+	"X-Ovh-Consumer",
+	"X-Ovh-Signature",
+	"X-Ovh-Timestamp",
+}
+
+// sensitiveFieldParts are the parts of the names of the JSON fields containing secrets
+var sensitiveFieldParts = []string{
+	"secret",
+	"password",
+	"token",
+	"consumerkey",
+	"privatekey",
+	"apikey",
+}
+
+// Interaction is a request sent to the API and the response received,
+// as stored in a cassette file (one JSON object per line).
+type Interaction struct {
+	Request struct {
+		Method  string      `json:"method"`
+		URL     string      `json:"url"`
+		Headers http.Header `json:"headers,omitempty"`
+		Body    string      `json:"body,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"status_code"`
+		Headers    http.Header `json:"headers,omitempty"`
+		Body       string      `json:"body,omitempty"`
+	} `json:"response"`
+}
+
+// cassette records the interactions in a file, or serves the ones
+// previously recorded instead of calling the API.
+type cassette struct {
+	mu sync.Mutex
+
+	// Path of the cassette file
+	path string
+
+	// File the interactions are appended to, when recording
+	file *os.File
+
+	// Recorded interactions and whether they have already been
+	// served, when replaying
+	interactions []*Interaction
+	served       []bool
+}
+
+// activeCassette is the cassette used by all the transports, if any
+var activeCassette *cassette
+
+// StartRecording records all the interactions with the API in the given file. Nothing
+// is done if the interactions are already recorded in this file, by a command running
+// other commands (shell, batch).
+func StartRecording(path string) error {
+	if activeCassette != nil && activeCassette.file != nil && activeCassette.path == path {
+		return nil
+	}
+	StopCassette()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create cassette file: %w", err)
+	}
+
+	activeCassette = &cassette{path: path, file: file}
+	return nil
+}
+
+// StartReplaying serves the interactions recorded in the given file instead of
+// calling the API. The API client is replaced by an unauthenticated one since
+// no credentials are needed. Nothing is done if the interactions of this file are
+// already served, by a command running other commands (shell, batch).
+func StartReplaying(path string) error {
+	if activeCassette != nil && activeCassette.file == nil && activeCassette.path == path {
+		return nil
+	}
+	StopCassette()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open cassette file: %w", err)
+	}
+	defer file.Close()
+
+	c := &cassette{path: path}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 64*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var interaction Interaction
+		if err := json.Unmarshal(scanner.Bytes(), &interaction); err != nil {
+			return fmt.Errorf("invalid interaction at line %d of cassette: %w", line, err)
+		}
+		c.interactions = append(c.interactions, &interaction)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read cassette file: %w", err)
+	}
+	c.served = make([]bool, len(c.interactions))
+
+	activeCassette = c
+
+	Client = &ovh.Client{
+		Client: &http.Client{
+			Transport: NewTransport("OVH", http.DefaultTransport),
+		},
+	}
+	return Client.SetEndpoint(ovh.OvhEU)
+}
+
+// StopCassette stops recording or replaying interactions. After a replay,
+// the API client of the active profile is restored.
+func StopCassette() {
+	if activeCassette == nil {
+		return
+	}
+
+	replaying := activeCassette.file == nil
+	if !replaying {
+		activeCassette.file.Close()
+	}
+	activeCassette = nil
+
+	if replaying {
+		InitClient()
+	}
+}
+
+// record appends the given request and response to the cassette file. The
+// response body is read and replaced so that it can still be consumed.
+func (c *cassette) record(req *http.Request, resp *http.Response) error {
+	var interaction Interaction
+
+	interaction.Request.Method = req.Method
+	interaction.Request.URL = req.URL.String()
+	interaction.Request.Headers = scrubHeaders(req.Header)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+		content, err := io.ReadAll(body)
+		body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+		interaction.Request.Body = scrubBody(content)
+	}
+
+	content, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(content))
+
+	interaction.Response.StatusCode = resp.StatusCode
+	interaction.Response.Headers = scrubHeaders(resp.Header)
+	interaction.Response.Body = scrubBody(content)
+
+	line, err := json.Marshal(interaction)
+	if err != nil {
+		return fmt.Errorf("failed to marshal interaction: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, err := c.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write cassette file: %w", err)
+	}
+	return nil
+}
+
+// replay returns the recorded response of the given request. Requests are matched
+// using their method, path, query and pagination cursor, the interactions being
+// served in the recorded order. Once all the matching interactions have been served,
+// the last one is served again, so that polling a resource keeps working.
+func (c *cassette) replay(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var last *Interaction
+	for i, interaction := range c.interactions {
+		if !interactionMatches(interaction, req) {
+			continue
+		}
+
+		last = interaction
+		if !c.served[i] {
+			c.served[i] = true
+			break
+		}
+	}
+
+	if last == nil {
+		return nil, fmt.Errorf("no recorded interaction for %s %s", req.Method, req.URL.RequestURI())
+	}
+
+	if req.Body != nil {
+		io.Copy(io.Discard, req.Body)
+		req.Body.Close()
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", last.Response.StatusCode, http.StatusText(last.Response.StatusCode)),
+		StatusCode:    last.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        last.Response.Headers.Clone(),
+		Body:          io.NopCloser(strings.NewReader(last.Response.Body)),
+		ContentLength: int64(len(last.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+func interactionMatches(interaction *Interaction, req *http.Request) bool {
+	if interaction.Request.Method != req.Method {
+		return false
+	}
+
+	if interaction.Request.Headers.Get("X-Pagination-Cursor") != req.Header.Get("X-Pagination-Cursor") {
+		return false
+	}
+
+	recordedURL, err := req.URL.Parse(interaction.Request.URL)
+	if err != nil {
+		return false
+	}
+	return recordedURL.Path == req.URL.Path && recordedURL.Query().Encode() == req.URL.Query().Encode()
+}
+
+// scrubHeaders returns a copy of the given headers with the credentials redacted
+func scrubHeaders(headers http.Header) http.Header {
+	scrubbed := headers.Clone()
+	for _, name := range sensitiveHeaders {
+		if scrubbed.Get(name) != "" {
+			scrubbed.Set(name, redactedValue)
+		}
+	}
+	return scrubbed
+}
+
+// scrubBody returns the given body with the values of the JSON fields
+// containing secrets redacted. Bodies that are not JSON are kept as is.
+func scrubBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return string(body)
+	}
+	if !scrubValue(value) {
+		return string(body)
+	}
+
+	scrubbed, err := json.Marshal(value)
+	if err != nil {
+		return string(body)
+	}
+	return string(scrubbed)
+}
+
+// scrubValue redacts the sensitive fields of the given decoded JSON value,
+// and returns whether a field has been redacted
+func scrubValue(value any) bool {
+	var scrubbed bool
+
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if _, ok := field.(string); ok && isSensitiveField(key) {
+				v[key] = redactedValue
+				scrubbed = true
+			} else if scrubValue(field) {
+				scrubbed = true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if scrubValue(item) {
+				scrubbed = true
+			}
+		}
+	}
+
+	return scrubbed
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	for _, part := range sensitiveFieldParts {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/maxatome/go-testdeep/td"
+)
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	previousClient := Client
+	t.Cleanup(func() {
+		activeCassette = nil
+		Client = previousClient
+	})
+
+	path := filepath.Join(t.TempDir(), "cassette.ndjson")
+	td.CmpNoError(t, StartRecording(path))
+
+	tr := NewTransport("OVH", roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"id":"1","name":"my-client","clientSecret":"s3cr3t"}`
+		if req.Header.Get("X-Pagination-Cursor") == "next" {
+			body = `["page-2"]`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	}))
+
+	req, _ := http.NewRequest(http.MethodPost, "https://eu.api.ovh.com/v1/me/api/oauth2/client", strings.NewReader(`{"name":"my-client","password":"p4ss"}`))
+	req.Header.Set("X-Ovh-Signature", "$1$abc")
+	req.Header.Set("Authorization", "Bearer token")
+	resp, err := tr.RoundTrip(req)
+	td.CmpNoError(t, err)
+
+	// The response can still be consumed after being recorded
+	body, _ := io.ReadAll(resp.Body)
+	td.Cmp(t, string(body), `{"id":"1","name":"my-client","clientSecret":"s3cr3t"}`)
+
+	req, _ = http.NewRequest(http.MethodGet, "https://eu.api.ovh.com/v1/vps", nil)
+	req.Header.Set("X-Pagination-Cursor", "next")
+	_, err = tr.RoundTrip(req)
+	td.CmpNoError(t, err)
+
+	StopCassette()
+
+	// Credentials and secrets are redacted
+	content, err := os.ReadFile(path)
+	td.CmpNoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	td.Cmp(t, lines, td.Len(2))
+	td.Cmp(t, json.RawMessage(lines[0]), td.JSON(`{
+		"request": {
+			"method": "POST",
+			"url": "https://eu.api.ovh.com/v1/me/api/oauth2/client",
+			"headers": {
+				"Authorization": ["[REDACTED]"],
+				"X-Ovh-Signature": ["[REDACTED]"]
+			},
+			"body": "{\"name\":\"my-client\",\"password\":\"[REDACTED]\"}"
+		},
+		"response": {
+			"status_code": 200,
+			"headers": {"Content-Type": ["application/json"]},
+			"body": "{\"clientSecret\":\"[REDACTED]\",\"id\":\"1\",\"name\":\"my-client\"}"
+		}
+	}`))
+
+	// Recorded responses are served without calling the API
+	td.CmpNoError(t, StartReplaying(path))
+	tr = NewTransport("OVH", roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected API call %s %s", req.Method, req.URL)
+		return nil, nil
+	}))
+
+	for range 2 {
+		req, _ = http.NewRequest(http.MethodGet, "https://ca.api.ovh.com/v1/vps", nil)
+		req.Header.Set("X-Pagination-Cursor", "next")
+		resp, err = tr.RoundTrip(req)
+		td.CmpNoError(t, err)
+		body, _ = io.ReadAll(resp.Body)
+		td.Cmp(t, string(body), `["page-2"]`)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, "https://eu.api.ovh.com/v1/vps", nil)
+	_, err = tr.RoundTrip(req)
+	td.CmpString(t, err, "no recorded interaction for GET /v1/vps")
+}
+
+func TestCassetteStartedByNestedCommand(t *testing.T) {
+	previousClient := Client
+	t.Cleanup(func() {
+		activeCassette = nil
+		Client = previousClient
+	})
+
+	var calls int
+	tr := NewTransport("OVH", roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`["vps-%d"]`, calls))),
+		}, nil
+	}))
+
+	// The cassette being recorded is not truncated by the next commands
+	path := filepath.Join(t.TempDir(), "cassette.ndjson")
+	for range 2 {
+		td.CmpNoError(t, StartRecording(path))
+		req, _ := http.NewRequest(http.MethodGet, "https://eu.api.ovh.com/v1/vps", nil)
+		_, err := tr.RoundTrip(req)
+		td.CmpNoError(t, err)
+	}
+	StopCassette()
+
+	content, err := os.ReadFile(path)
+	td.CmpNoError(t, err)
+	td.Cmp(t, strings.Split(strings.TrimSpace(string(content)), "\n"), td.Len(2))
+
+	// The interactions are served in order by the next commands
+	for i := range 2 {
+		td.CmpNoError(t, StartReplaying(path))
+		req, _ := http.NewRequest(http.MethodGet, "https://eu.api.ovh.com/v1/vps", nil)
+		resp, err := tr.RoundTrip(req)
+		td.CmpNoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		td.Cmp(t, string(body), fmt.Sprintf(`["vps-%d"]`, i+1))
+	}
+	td.Cmp(t, calls, 2)
+}
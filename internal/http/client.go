@@ -14,15 +14,19 @@ import (
 	"runtime"
 
 	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/ovhcloud-cli/internal/config"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/version"
 	"golang.org/x/sync/errgroup"
-	"golang.org/x/sync/semaphore"
 )
 
 // OVH API client
 var Client *ovh.Client
 
+// ClientProfile is the name of the configuration profile used to
+// initialize the API client (empty when using the default configuration)
+var ClientProfile string
+
 func InitClient() {
 	var err error
 
@@ -35,11 +39,13 @@ func InitClient() {
 		Client.UserAgent = os.Getenv("OVH_USER_AGENT")
 		Client.SetEndpoint(os.Getenv("OVH_ENDPOINT"))
 	} else {
-		Client, err = ovh.NewDefaultClient()
+		Client, err = newProfileClient(config.ActiveProfile)
 		if Client != nil {
 			Client.UserAgent = "ovh-cli/" + version.Version
 		}
 	}
+	ClientProfile = config.ActiveProfile
+
 	if err != nil {
 		log.Printf(`OVHcloud API client not initialized, please run "ovhcloud login" to authenticate (%s)`, err)
 	} else {
@@ -47,62 +53,194 @@ func InitClient() {
 	}
 }
 
-func FetchObjectsParallel[T any](path string, ids []any, ignoreErrors bool) ([]T, error) {
+// newProfileClient returns an API client configured with the endpoint and
+// credentials of the given profile, or the default client if no profile is given.
+func newProfileClient(profile string) (*ovh.Client, error) {
+	if profile == "" {
+		return ovh.NewDefaultClient()
+	}
+
+	values, err := config.GetProfile(flags.CliConfig, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the endpoint URL so that the client does not complete the
+	// profile's credentials with the ones of the endpoint's section
+	endpoint := values["endpoint"]
+	if endpoint == "" {
+		endpoint = ovh.OvhEU
+	} else if endpointURL, ok := ovh.Endpoints[endpoint]; ok {
+		endpoint = endpointURL
+	}
+
+	switch {
+	case values["client_id"] != "" || values["client_secret"] != "":
+		return ovh.NewOAuth2Client(endpoint, values["client_id"], values["client_secret"])
+	case values["access_token"] != "":
+		return ovh.NewAccessTokenClient(endpoint, values["access_token"])
+	default:
+		return ovh.NewClient(endpoint, values["application_key"], values["application_secret"], values["consumer_key"])
+	}
+}
+
+// FetchObjectsParallel fetches the objects of the given IDs using flags.Parallel concurrent
+// requests. When the given context is cancelled, the objects fetched so far are returned
+// along with the context error.
+func FetchObjectsParallel[T any](ctx context.Context, path string, ids []any, ignoreErrors bool) ([]T, error) {
+	objects := make([]T, 0, len(ids))
+
+	err := FetchObjectsStream(ctx, path, ids, ignoreErrors, func(_ int, object T) error {
+		objects = append(objects, object)
+		return nil
+	})
+
+	return objects, err
+}
+
+// FetchObjectsStream fetches the objects of the given IDs using flags.Parallel concurrent
+// requests, and calls the given function with each object as soon as it is fetched. The
+// function is called sequentially, in the order of the given IDs. When ignoring errors,
+// the objects that cannot be fetched are skipped. The fetch stops when the given context
+// is cancelled, after handling the objects already fetched.
+func FetchObjectsStream[T any](parent context.Context, path string, ids []any, ignoreErrors bool, handle func(index int, object T) error) error {
+	type result struct {
+		index   int
+		object  T
+		err     error
+		skipped bool
+	}
+
 	var (
-		parallelRequests = 10
-		sem              = semaphore.NewWeighted(int64(parallelRequests))
-		objects          = make([]T, len(ids))
-		g, ctx           = errgroup.WithContext(context.Background())
+		ctx, cancel = context.WithCancel(parent)
+		indexes     = make(chan int)
+		results     = make(chan result)
+		workers     errgroup.Group
 	)
+	defer cancel()
 
-	for i, id := range ids {
-		if err := sem.Acquire(ctx, 1); err != nil {
-			// Here the error is ctx.Err(), so just log it and
-			// let the g.Wait() return the "real" error
-			log.Printf("failed to acquire semaphore: %s", err)
-			break
+	go func() {
+		defer close(indexes)
+		for index := range ids {
+			select {
+			case indexes <- index:
+			case <-ctx.Done():
+				return
+			}
 		}
+	}()
+
+	for range min(max(flags.Parallel, 1), len(ids)) {
+		workers.Go(func() error {
+			for index := range indexes {
+				url := fmt.Sprintf(path, url.PathEscape(fmt.Sprint(ids[index])))
 
-		g.Go(func() error {
-			defer sem.Release(1)
-			url := fmt.Sprintf(path, url.PathEscape(fmt.Sprint(id)))
+				res := result{index: index}
+				if err := Client.GetWithContext(ctx, url, &res.object); err != nil {
+					if ignoreErrors && ctx.Err() == nil {
+						log.Printf("error fetching %s: %s", url, err)
+						res.skipped = true
+					} else {
+						res.err = fmt.Errorf("failed to fetch object %q: %w", fmt.Sprint(ids[index]), err)
+					}
+				}
 
-			var object T
-			if err := Client.Get(url, &object); err != nil {
-				if ignoreErrors {
-					log.Printf("error fetching %s: %s", url, err)
+				select {
+				case results <- res:
+				case <-ctx.Done():
 					return nil
 				}
-				return fmt.Errorf("failed to fetch object %q: %w", fmt.Sprint(id), err)
 			}
-
-			objects[i] = object
-
 			return nil
 		})
 	}
 
-	if err := g.Wait(); err != nil {
-		return nil, err
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	// Handle the results in the order of the IDs, buffering
+	// the ones fetched before the previous objects
+	var (
+		pending = make(map[int]result)
+		next    int
+	)
+	for res := range results {
+		if res.err != nil {
+			return res.err
+		}
+		pending[res.index] = res
+
+		for {
+			res, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+
+			if err := parent.Err(); err != nil {
+				return err
+			}
+			if res.skipped {
+				continue
+			}
+			if err := handle(res.index, res.object); err != nil {
+				return err
+			}
+		}
 	}
 
-	return objects, nil
+	return parent.Err()
 }
 
 // fetchArray calls the given path (and expects it to return an array), and
 // paginates to fetch all the results.
 // If "idField" given, it tries to extract the given field from the objects returned
 // by the API call.
-func FetchArray(path, idField string) ([]any, error) {
+// When the given context is cancelled, the IDs fetched so far are returned along
+// with the error.
+func FetchArray(ctx context.Context, path, idField string) ([]any, error) {
+	var allIDs []any
+
+	err := fetchPages(ctx, path, func(response *http.Response) (bool, error) {
+		var pageIDs []any
+		if err := Client.UnmarshalResponse(response, &pageIDs); err != nil {
+			return false, fmt.Errorf("failed to parse ids: %s", err)
+		}
+
+		if idField == "" {
+			allIDs = append(allIDs, pageIDs...)
+			return true, nil
+		}
+
+		for _, item := range pageIDs {
+			object, ok := item.(map[string]any)
+			if !ok {
+				return false, fmt.Errorf("failed to extract ID from object, value %q is not an object", item)
+			}
+			allIDs = append(allIDs, object[idField])
+		}
+
+		return true, nil
+	})
+
+	return allIDs, err
+}
+
+// fetchPages calls the given path, then the next pages given by the cursor pagination,
+// and calls the given function with the response of each page. The pagination stops
+// at the last page, or when the function returns false or an error.
+func fetchPages(ctx context.Context, path string, handle func(response *http.Response) (bool, error)) error {
 	req, err := Client.NewRequest(http.MethodGet, path, nil, true)
 	if err != nil {
-		return nil, fmt.Errorf("error crafting request: %s", err)
+		return fmt.Errorf("error crafting request: %s", err)
 	}
 
-	var (
-		allIDs     []any
-		nextCursor string
-	)
+	req = req.WithContext(ctx)
+
+	var nextCursor string
 
 	for {
 		if nextCursor != "" {
@@ -111,57 +249,80 @@ func FetchArray(path, idField string) ([]any, error) {
 
 		response, err := Client.Do(req)
 		if err != nil {
-			return nil, fmt.Errorf("error fetching %s: %s", path, err)
+			return fmt.Errorf("error fetching %s: %w", path, err)
 		}
 
-		var pageIDs []any
-		if err := Client.UnmarshalResponse(response, &pageIDs); err != nil {
-			return nil, fmt.Errorf("failed to parse ids: %s", err)
-		}
-
-		if idField != "" {
-			for _, item := range pageIDs {
-				object, ok := item.(map[string]any)
-				if !ok {
-					return nil, fmt.Errorf("failed to extract ID from object, value %q is not an object", item)
-				}
-				allIDs = append(allIDs, object[idField])
-			}
-		} else {
-			allIDs = append(allIDs, pageIDs...)
+		if next, err := handle(response); err != nil || !next {
+			return err
 		}
 
 		nextCursor = response.Header.Get("X-Pagination-Cursor-Next")
 		if nextCursor == "" {
-			break
+			return nil
 		}
 	}
+}
 
-	return allIDs, nil
+// FetchExpandedArray fetches the IDs returned by the given path, and the objects
+// corresponding to each of them. When the given context is cancelled, the objects
+// fetched so far are returned along with the error.
+func FetchExpandedArray(ctx context.Context, path, idField string) ([]map[string]any, error) {
+	objects := []map[string]any{}
+
+	err := FetchExpandedArrayStream(ctx, path, idField, func(object map[string]any) error {
+		objects = append(objects, object)
+		return nil
+	})
+
+	return objects, err
 }
 
-func FetchExpandedArray(path, idField string) ([]map[string]any, error) {
-	ids, err := FetchArray(path, idField)
+// FetchExpandedArrayStream fetches the IDs returned by the given path, and calls the
+// given function with each of the corresponding objects as soon as it is fetched.
+func FetchExpandedArrayStream(ctx context.Context, path, idField string, handle func(object map[string]any) error) error {
+	ids, err := FetchArray(ctx, path, idField)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch ids: %w", err)
+		return fmt.Errorf("failed to fetch ids: %w", err)
 	}
 
-	objects, err := FetchObjectsParallel[map[string]any](path+"/%s", ids, flags.IgnoreErrors)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch objects: %w", err)
+	if err := FetchObjectsStream(ctx, path+"/%s", ids, flags.IgnoreErrors, func(_ int, object map[string]any) error {
+		return handle(object)
+	}); err != nil {
+		return fmt.Errorf("failed to fetch objects: %w", err)
 	}
 
-	// If we ignore errors, we can have nil entries in the output, so filter them out
-	if flags.IgnoreErrors {
-		withNilFiltered := make([]map[string]any, 0, len(objects))
-		for _, obj := range objects {
-			if obj != nil {
-				withNilFiltered = append(withNilFiltered, obj)
-			}
+	return nil
+}
+
+// FetchRaw calls the given path and returns the decoded response, whatever
+// its type. If the API returns an array, all the pages are fetched using
+// cursor pagination, as done by FetchArray.
+func FetchRaw(ctx context.Context, path string) (any, error) {
+	var (
+		result   any
+		allItems = []any{}
+	)
+
+	err := fetchPages(ctx, path, func(response *http.Response) (bool, error) {
+		var page any
+		if err := Client.UnmarshalResponse(response, &page); err != nil {
+			return false, err
 		}
 
-		return withNilFiltered, nil
+		// Response is not an array, no pagination to handle
+		pageItems, ok := page.([]any)
+		if !ok {
+			result = page
+			return false, nil
+		}
+		allItems = append(allItems, pageItems...)
+		result = allItems
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return objects, nil
+	return result, nil
 }
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+)
+
+func TestFetchObjectsStream(t *testing.T) {
+	httpmock.Activate(t)
+
+	previousClient, previousParallel := Client, flags.Parallel
+	t.Cleanup(func() { Client, flags.Parallel = previousClient, previousParallel })
+
+	client, err := ovh.NewClient("ovh-eu", "app_key", "app_secret", "consumer_key")
+	td.Require(t).CmpNoError(err)
+	Client = client
+	flags.Parallel = 3
+
+	httpmock.RegisterResponder(http.MethodGet, "https://eu.api.ovh.com/1.0/auth/time",
+		httpmock.NewStringResponder(200, "0"))
+
+	var running, maxRunning atomic.Int32
+	httpmock.RegisterResponder(http.MethodGet, `=~^https://eu\.api\.ovh\.com/v1/vps/(\d+)\z`,
+		func(req *http.Request) (*http.Response, error) {
+			current := running.Add(1)
+			defer running.Add(-1)
+			for {
+				previous := maxRunning.Load()
+				if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+
+			// Answer the first IDs last, to check that the objects are handled in order
+			id, _ := httpmock.GetSubmatchAsInt(req, 1)
+			time.Sleep(time.Duration(10-id) * 5 * time.Millisecond)
+
+			if id == 4 {
+				return httpmock.NewStringResponse(404, `{"message": "not found"}`), nil
+			}
+			return httpmock.NewStringResponse(200, fmt.Sprintf(`{"id": %d}`, id)), nil
+		})
+
+	ids := []any{1, 2, 3, 4, 5, 6, 7, 8}
+
+	var handled []int
+	err = FetchObjectsStream(context.Background(), "/v1/vps/%s", ids, true, func(index int, object map[string]any) error {
+		handled = append(handled, index)
+		return nil
+	})
+
+	td.CmpNoError(t, err)
+	td.Cmp(t, handled, []int{0, 1, 2, 4, 5, 6, 7})
+	td.Cmp(t, maxRunning.Load(), td.Between(int32(1), int32(3)))
+
+	// Without ignoring errors, the first error stops the fetch
+	err = FetchObjectsStream(context.Background(), "/v1/vps/%s", ids, false, func(index int, object map[string]any) error {
+		return nil
+	})
+	td.Cmp(t, err, td.String(`failed to fetch object "4": OVHcloud API error (status code 404): "not found"`))
+
+	// When the context is cancelled, the fetch stops after the objects already handled
+	ctx, cancel := context.WithCancel(context.Background())
+	handled = nil
+	err = FetchObjectsStream(ctx, "/v1/vps/%s", ids, true, func(index int, object map[string]any) error {
+		handled = append(handled, index)
+		if index == 1 {
+			cancel()
+		}
+		return nil
+	})
+	td.CmpErrorIs(t, err, context.Canceled)
+	td.Cmp(t, handled, []int{0, 1})
+}
+
+func TestRateLimiter(t *testing.T) {
+	previousRateLimit := flags.RateLimit
+	t.Cleanup(func() { flags.RateLimit = previousRateLimit })
+	flags.RateLimit = 50
+
+	limiter := &rateLimiter{}
+	start := time.Now()
+
+	// The first 50 requests are sent in a burst, the following ones at the given rate
+	for range 60 {
+		td.CmpNoError(t, limiter.wait(context.Background()))
+	}
+	td.Cmp(t, time.Since(start), td.Between(180*time.Millisecond, time.Second))
+
+	// The wait is interrupted when the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	td.CmpErrorIs(t, limiter.wait(ctx), context.Canceled)
+}
@@ -11,6 +11,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httputil"
@@ -31,6 +32,11 @@ type DebugLogEntry struct {
 	RequestID   string
 	Duration    time.Duration
 	Error       string
+
+	// Attempt is the number of the attempt, starting at 0 for the first
+	// call, and RetryDelay the time waited before retrying the call
+	Attempt    int
+	RetryDelay time.Duration
 }
 
 // DebugLogger holds the debug log entries for the browser
@@ -85,7 +91,7 @@ func (e DebugLogEntry) Format() string {
 	if reqID == "" {
 		reqID = "-"
 	}
-	return fmt.Sprintf("[%s] %s %s → %s (%s) RequestID: %s",
+	line := fmt.Sprintf("[%s] %s %s → %s (%s) RequestID: %s",
 		e.Timestamp.Format("15:04:05"),
 		e.Method,
 		e.URL,
@@ -93,6 +99,13 @@ func (e DebugLogEntry) Format() string {
 		e.Duration.Round(time.Millisecond),
 		reqID,
 	)
+	if e.Attempt > 0 {
+		line += fmt.Sprintf(" [retry %d]", e.Attempt)
+	}
+	if e.RetryDelay > 0 {
+		line += fmt.Sprintf(" → retrying in %s", e.RetryDelay.Round(time.Millisecond))
+	}
+	return line
 }
 
 type transport struct {
@@ -100,7 +113,66 @@ type transport struct {
 	transport http.RoundTripper
 }
 
+// RoundTrip sends the given request within the limits of the requests rate,
+// retrying it when it fails with a transient error (see retryDelay). In dry-run
+// mode, the requests that may modify resources are printed instead of being sent.
+// When a cassette is active, the final response is recorded, or the recorded
+// response is served instead of calling the API.
 func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if flags.DryRun && isMutating(req) {
+		return dryRun(req)
+	}
+
+	if activeCassette != nil && activeCassette.file == nil {
+		return activeCassette.replay(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, delay, retry, err := t.roundTrip(req, attempt)
+		if !retry {
+			if err == nil && activeCassette != nil {
+				if err := activeCassette.record(req, resp); err != nil {
+					log.Printf("failed to record %s %s: %s", req.Method, req.URL.Path, err)
+				}
+			}
+			return resp, err
+		}
+
+		if flags.Debug {
+			log.Printf("[DEBUG] %s API Request %s %s failed, retrying in %s (%d/%d)", t.name, req.Method, req.URL.Path, delay, attempt+1, flags.MaxRetries)
+		}
+
+		// Discard the response of the failed attempt to reuse the connection
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+
+		// Rewind the request body for the next attempt
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// roundTrip sends a single attempt of the given request, and returns whether
+// it has to be retried and after which delay.
+func (t *transport) roundTrip(req *http.Request, attempt int) (*http.Response, time.Duration, bool, error) {
+	if err := requestsLimiter.wait(req.Context()); err != nil {
+		return nil, 0, false, err
+	}
+
 	startTime := time.Now()
 
 	if flags.Debug {
@@ -114,6 +186,7 @@ func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 	resp, err := t.transport.RoundTrip(req)
 	duration := time.Since(startTime)
+	delay, retry := retryDelay(req, resp, err, attempt)
 
 	// Create debug log entry
 	entry := DebugLogEntry{
@@ -122,12 +195,16 @@ func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		URL:         req.URL.Path,
 		QueryString: req.URL.RawQuery,
 		Duration:    duration,
+		Attempt:     attempt,
+	}
+	if retry {
+		entry.RetryDelay = delay
 	}
 
 	if err != nil {
 		entry.Error = err.Error()
 		BrowserDebugLogger.AddEntry(entry)
-		return resp, err
+		return resp, delay, retry, err
 	}
 
 	entry.StatusCode = resp.StatusCode
@@ -145,7 +222,7 @@ func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		}
 	}
 
-	return resp, nil
+	return resp, delay, retry, nil
 }
 
 // NewTransport creates a wrapper around a *http.RoundTripper,
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+)
+
+// dryRunOutput is where the intercepted requests are printed
+var dryRunOutput io.Writer = os.Stdout
+
+// dryRunRequest is the structured description of an intercepted request
+type dryRunRequest struct {
+	DryRun bool            `json:"dry_run"`
+	Method string          `json:"method"`
+	URL    string          `json:"url"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+// isMutating returns whether the given request may modify resources
+func isMutating(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// dryRun prints the given request instead of sending it, and returns a
+// synthetic successful response with a null body.
+func dryRun(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		content, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		body = bytes.TrimSpace(content)
+	}
+
+	if err := printDryRunRequest(req.Method, req.URL.String(), body); err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader("null")),
+		ContentLength: 4,
+		Request:       req,
+	}, nil
+}
+
+// printDryRunRequest prints the method, URL and body of an intercepted request,
+// as a JSON object when the JSON output format is selected.
+func printDryRunRequest(method, url string, body []byte) error {
+	if flags.OutputFormatConfig.IsJson() || flags.OutputFormatConfig.IsNdjson() {
+		request := dryRunRequest{
+			DryRun: true,
+			Method: method,
+			URL:    url,
+		}
+		if json.Valid(body) {
+			request.Body = body
+		} else if len(body) > 0 {
+			request.Body, _ = json.Marshal(string(body))
+		}
+
+		var (
+			out []byte
+			err error
+		)
+		if flags.OutputFormatConfig.IsNdjson() {
+			out, err = json.Marshal(request)
+		} else {
+			out, err = json.MarshalIndent(request, "", "  ")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to marshal dry-run request: %w", err)
+		}
+
+		_, err = fmt.Fprintln(dryRunOutput, string(out))
+		return err
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "🔎 Dry run, request not sent: %s %s\n", method, url)
+	if len(body) > 0 {
+		var indented bytes.Buffer
+		if json.Indent(&indented, body, "", "  ") == nil {
+			body = indented.Bytes()
+		}
+		fmt.Fprintln(&out, string(body))
+	}
+
+	_, err := fmt.Fprint(dryRunOutput, out.String())
+	return err
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+)
+
+// newDryRunTestTransport enables the dry-run mode and returns a transport
+// counting the requests actually sent, and the buffer receiving the output.
+func newDryRunTestTransport(t *testing.T, output string) (*transport, *int, *bytes.Buffer) {
+	previousDryRun, previousOutputFormat, previousOutput := flags.DryRun, flags.OutputFormatConfig, dryRunOutput
+	t.Cleanup(func() {
+		flags.DryRun, flags.OutputFormatConfig, dryRunOutput = previousDryRun, previousOutputFormat, previousOutput
+	})
+
+	var out bytes.Buffer
+	flags.DryRun = true
+	flags.OutputFormatConfig = display.OutputFormat{Output: output}
+	dryRunOutput = &out
+
+	var sent int
+	return NewTransport("OVH", roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		sent++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(`{"id":"1"}`)),
+		}, nil
+	})), &sent, &out
+}
+
+func TestDryRun(t *testing.T) {
+	tr, sent, out := newDryRunTestTransport(t, "")
+
+	// Read-only requests are sent
+	req, _ := http.NewRequest(http.MethodGet, "https://eu.api.ovh.com/v1/vps/vps-1", nil)
+	resp, err := tr.RoundTrip(req)
+	td.CmpNoError(t, err)
+	td.Cmp(t, resp.StatusCode, 200)
+	td.Cmp(t, *sent, 1)
+	td.Cmp(t, out.String(), "")
+
+	// Mutating requests are printed and not sent
+	req, _ = http.NewRequest(http.MethodPost, "https://eu.api.ovh.com/v1/vps/vps-1/rebuild", strings.NewReader(`{"imageId":"abc","doNotSendPassword":true}`))
+	resp, err = tr.RoundTrip(req)
+	td.CmpNoError(t, err)
+	td.Cmp(t, *sent, 1)
+	body, _ := io.ReadAll(resp.Body)
+	td.Cmp(t, resp.StatusCode, 200)
+	td.Cmp(t, string(body), "null")
+	td.Cmp(t, out.String(), `🔎 Dry run, request not sent: POST https://eu.api.ovh.com/v1/vps/vps-1/rebuild
+{
+  "imageId": "abc",
+  "doNotSendPassword": true
+}
+`)
+
+	out.Reset()
+	req, _ = http.NewRequest(http.MethodDelete, "https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/inst-1", nil)
+	_, err = tr.RoundTrip(req)
+	td.CmpNoError(t, err)
+	td.Cmp(t, *sent, 1)
+	td.Cmp(t, out.String(), "🔎 Dry run, request not sent: DELETE https://eu.api.ovh.com/v1/cloud/project/fakeProjectID/instance/inst-1\n")
+}
+
+func TestDryRunJSON(t *testing.T) {
+	tr, sent, out := newDryRunTestTransport(t, "json")
+
+	req, _ := http.NewRequest(http.MethodPut, "https://eu.api.ovh.com/v1/vps/vps-1", strings.NewReader(`{"displayName":"new name"}`))
+	_, err := tr.RoundTrip(req)
+	td.CmpNoError(t, err)
+	td.Cmp(t, *sent, 0)
+	td.Cmp(t, json.RawMessage(out.Bytes()), td.JSON(`{
+		"dry_run": true,
+		"method": "PUT",
+		"url": "https://eu.api.ovh.com/v1/vps/vps-1",
+		"body": {"displayName": "new name"}
+	}`))
+}
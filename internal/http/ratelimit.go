@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+)
+
+const (
+	// DefaultParallelRequests is the number of concurrent requests made to
+	// fetch the items of a list when not defined by --parallel flag or
+	// parallel configuration key
+	DefaultParallelRequests = 10
+
+	// DefaultRateLimit is the maximum number of requests per second when not
+	// defined by --rate-limit flag or rate_limit configuration key
+	DefaultRateLimit = 20
+)
+
+// rateLimiter is a token bucket limiting the number of requests sent per
+// second to flags.RateLimit, with bursts of at most flags.RateLimit requests.
+type rateLimiter struct {
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// requestsLimiter is shared by all the requests sent to the API
+var requestsLimiter = &rateLimiter{}
+
+// wait blocks until a request can be sent without exceeding the rate limit,
+// or until the given context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		rate := flags.RateLimit
+		if rate <= 0 {
+			return nil
+		}
+		burst := max(rate, 1)
+
+		l.mu.Lock()
+		now := time.Now()
+		if l.last.IsZero() {
+			l.tokens = burst
+		} else {
+			l.tokens = min(burst, l.tokens+now.Sub(l.last).Seconds()*rate)
+		}
+		l.last = now
+
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - l.tokens) / rate * float64(time.Second))
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+)
+
+const (
+	// DefaultMaxRetries is the number of retries of a failed request when
+	// not defined by --max-retries flag or max_retries configuration key
+	DefaultMaxRetries = 3
+
+	// MaxRetriesLimit is the highest number of retries that can be configured
+	MaxRetriesLimit = 10
+
+	// Bounds of the exponential backoff between two attempts
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+
+	// maxRetryAfter is the longest Retry-After delay that is honoured, the
+	// response is returned as-is when the API asks to wait longer
+	maxRetryAfter = 5 * time.Minute
+)
+
+// retryableStatusCodes are the status codes of the responses that denote a
+// transient error (rate limiting or unavailable service)
+var retryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// isIdempotent returns whether the given request can safely be sent several
+// times, following the same rules as the standard library.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	_, hasKey := req.Header["Idempotency-Key"]
+	_, hasXKey := req.Header["X-Idempotency-Key"]
+
+	return hasKey || hasXKey
+}
+
+// retryDelay returns the time to wait before sending the given request again,
+// and false if the request must not be retried.
+func retryDelay(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if attempt >= flags.MaxRetries || !isIdempotent(req) || req.Context().Err() != nil {
+		return 0, false
+	}
+
+	if err != nil {
+		return backoffDelay(attempt), true
+	}
+
+	if !slices.Contains(retryableStatusCodes, resp.StatusCode) {
+		return 0, false
+	}
+
+	if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+		return delay, delay <= maxRetryAfter
+	}
+
+	return backoffDelay(attempt), true
+}
+
+// backoffDelay returns an exponential delay for the given attempt, with a
+// random jitter to avoid retrying all the parallel requests at the same time.
+func backoffDelay(attempt int) time.Duration {
+	// Stop doubling once the maximum is reached, to not overflow
+	delay := retryBaseDelay
+	for range attempt {
+		if delay >= retryMaxDelay {
+			break
+		}
+		delay *= 2
+	}
+	delay = min(delay, retryMaxDelay)
+
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// parseRetryAfter parses the value of a Retry-After header, that can
+// either be a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
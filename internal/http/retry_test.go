@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maxatome/go-testdeep/td"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// newRetryTestTransport returns a transport answering the given status codes
+// in sequence, and a pointer to the bodies of the received requests.
+func newRetryTestTransport(t *testing.T, statusCodes ...int) (*transport, *[]string) {
+	previousMaxRetries := flags.MaxRetries
+	flags.MaxRetries = DefaultMaxRetries
+	BrowserDebugLogger.Clear()
+	t.Cleanup(func() {
+		flags.MaxRetries = previousMaxRetries
+		BrowserDebugLogger.Clear()
+	})
+
+	var bodies []string
+	return NewTransport("OVH", roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		body := ""
+		if req.Body != nil {
+			content, _ := io.ReadAll(req.Body)
+			body = string(content)
+		}
+		bodies = append(bodies, body)
+
+		resp := &http.Response{
+			StatusCode: statusCodes[len(bodies)-1],
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader("{}")),
+		}
+		if resp.StatusCode == http.StatusTooManyRequests {
+			resp.Header.Set("Retry-After", "0")
+		}
+		return resp, nil
+	})), &bodies
+}
+
+func TestRoundTripRetriesIdempotentRequests(t *testing.T) {
+	tr, bodies := newRetryTestTransport(t, 429, 503, 200)
+
+	req, _ := http.NewRequest(http.MethodPut, "https://eu.api.ovh.com/v1/me", strings.NewReader(`{"name":"test"}`))
+	resp, err := tr.RoundTrip(req)
+
+	td.CmpNoError(t, err)
+	td.Cmp(t, resp.StatusCode, 200)
+	td.Cmp(t, *bodies, []string{`{"name":"test"}`, `{"name":"test"}`, `{"name":"test"}`})
+
+	entries := BrowserDebugLogger.GetEntries()
+	td.Cmp(t, entries, td.Len(3))
+	td.Cmp(t, entries[0], td.SStruct(DebugLogEntry{StatusCode: 429, Attempt: 0, RetryDelay: 0}, td.StructFields{
+		"Timestamp": td.Ignore(), "Method": "PUT", "URL": "/v1/me", "Duration": td.Ignore(),
+	}))
+	td.Cmp(t, entries[1].Attempt, 1)
+	td.Cmp(t, entries[1].RetryDelay, td.Between(retryBaseDelay, 2*retryBaseDelay))
+	td.Cmp(t, entries[2].Attempt, 2)
+	td.Cmp(t, entries[2].RetryDelay, time.Duration(0))
+}
+
+func TestRoundTripDoesNotRetryNonIdempotentRequests(t *testing.T) {
+	tr, bodies := newRetryTestTransport(t, 503, 200)
+
+	req, _ := http.NewRequest(http.MethodPost, "https://eu.api.ovh.com/v1/me", strings.NewReader(`{}`))
+	resp, err := tr.RoundTrip(req)
+
+	td.CmpNoError(t, err)
+	td.Cmp(t, resp.StatusCode, 503)
+	td.Cmp(t, *bodies, td.Len(1))
+}
+
+func TestRoundTripMaxRetries(t *testing.T) {
+	tr, bodies := newRetryTestTransport(t, 429, 429, 200)
+	flags.MaxRetries = 1
+
+	req, _ := http.NewRequest(http.MethodGet, "https://eu.api.ovh.com/v1/me", nil)
+	resp, err := tr.RoundTrip(req)
+
+	td.CmpNoError(t, err)
+	td.Cmp(t, resp.StatusCode, 429)
+	td.Cmp(t, *bodies, td.Len(2))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	delay, ok := parseRetryAfter("12")
+	td.CmpTrue(t, ok)
+	td.Cmp(t, delay, 12*time.Second)
+
+	delay, ok = parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	td.CmpTrue(t, ok)
+	td.Cmp(t, delay, td.Between(58*time.Second, time.Minute))
+
+	_, ok = parseRetryAfter("soon")
+	td.CmpFalse(t, ok)
+}
+
+func TestBackoffDelayLargeAttempt(t *testing.T) {
+	for _, attempt := range []int{0, 6, 36, 64, 1000} {
+		td.Cmp(t, backoffDelay(attempt), td.Between(retryBaseDelay/2, retryMaxDelay), "attempt %d", attempt)
+	}
+}
@@ -0,0 +1,450 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mockapi implements a local API server answering the calls described
+// by OpenAPI schemas with example or synthesized responses.
+package mockapi
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/ovh/ovhcloud-cli/internal/utils"
+)
+
+// DefaultPageSize is the number of items returned per page of a list when
+// the X-Pagination-Size header is not given
+const DefaultPageSize = 100
+
+// route is a path of an API schema, served under the prefix of the API version
+type route struct {
+	segments []string
+	item     *openapi3.PathItem
+
+	// itemParam is the name of the parameter identifying the items of
+	// the collection served by this route, if it is a collection
+	itemParam string
+
+	// itemRoute is the route of the items of this collection
+	itemRoute *route
+}
+
+// collection is the in-memory state of a collection of resources
+type collection struct {
+	ids     []string
+	objects map[string]map[string]any
+}
+
+// Server serves the paths of the loaded API schemas. Resources created with POST
+// calls on collections are kept in memory, and can be fetched, listed, updated
+// and deleted until the server stops.
+type Server struct {
+	routes []*route
+
+	mu          sync.Mutex
+	collections map[string]*collection
+	lastID      int
+}
+
+// NewServer returns a server for the given OpenAPI schemas. The paths of each
+// schema are served under the version prefix of its server URL (/v1 or /v2).
+func NewServer(schemas ...[]byte) (*Server, error) {
+	s := &Server{collections: make(map[string]*collection)}
+
+	for _, schema := range schemas {
+		if len(schema) == 0 {
+			continue
+		}
+
+		doc, err := openapi3.NewLoader().LoadFromData(schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load schema: %w", err)
+		}
+		if doc.Paths == nil {
+			continue
+		}
+
+		prefix := "/v1"
+		if len(doc.Servers) > 0 && strings.HasSuffix(doc.Servers[0].URL, "/v2") {
+			prefix = "/v2"
+		}
+
+		for path, item := range doc.Paths.Map() {
+			s.routes = append(s.routes, &route{
+				segments: splitPath(prefix + path),
+				item:     item,
+			})
+		}
+	}
+
+	// Find the collections, and prefer the routes having literal segments
+	// over the ones having parameters (e.g. /vps/datacenter over /vps/{serviceName})
+	for _, r := range s.routes {
+		for _, other := range s.routes {
+			if len(other.segments) == len(r.segments)+1 &&
+				slices.Equal(other.segments[:len(r.segments)], r.segments) &&
+				isParam(other.segments[len(r.segments)]) {
+				r.itemParam = strings.Trim(other.segments[len(r.segments)], "{}")
+				r.itemRoute = other
+			}
+		}
+	}
+	slices.SortFunc(s.routes, func(a, b *route) int {
+		if c := cmp.Compare(len(a.segments), len(b.segments)); c != 0 {
+			return c
+		}
+		for i := range a.segments {
+			if aParam, bParam := isParam(a.segments[i]), isParam(b.segments[i]); aParam != bParam {
+				if aParam {
+					return 1
+				}
+				return -1
+			}
+		}
+		return slices.Compare(a.segments, b.segments)
+	})
+
+	return s, nil
+}
+
+// ServeHTTP answers the given request with the state of the resources, or with
+// a response synthesized from the schema of the operation.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	log.Printf("%s %s", req.Method, req.URL.RequestURI())
+
+	// Time used by the clients to sign their requests
+	if req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/auth/time") {
+		writeJSON(w, http.StatusOK, time.Now().Unix())
+		return
+	}
+
+	segments := splitPath(req.URL.Path)
+	r := s.findRoute(segments)
+	if r == nil {
+		writeError(w, http.StatusNotFound, "Client::NotFound", fmt.Sprintf("Got an invalid (or empty) URL: %s", req.URL.Path))
+		return
+	}
+
+	op := r.item.GetOperation(req.Method)
+	if op == nil {
+		writeError(w, http.StatusMethodNotAllowed, "Client::MethodNotAllowed", fmt.Sprintf("Method %s is not allowed on %s", req.Method, req.URL.Path))
+		return
+	}
+
+	var body map[string]any
+	if req.Body != nil {
+		content, err := io.ReadAll(req.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Client::BadRequest", "Failed to read request body")
+			return
+		}
+		if len(content) > 0 {
+			if err := json.Unmarshal(content, &body); err != nil {
+				writeError(w, http.StatusBadRequest, "Client::BadRequest", fmt.Sprintf("Invalid request body: %s", err))
+				return
+			}
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := "/" + strings.Join(segments, "/")
+	switch {
+	case r.itemRoute != nil && req.Method == http.MethodGet:
+		s.list(w, req, r, op, path)
+	case r.itemRoute != nil && req.Method == http.MethodPost:
+		s.create(w, r, op, path, body)
+	case len(r.segments) > 0 && isParam(r.segments[len(r.segments)-1]):
+		s.handleItem(w, req, r, op, parentPath(segments), segments[len(segments)-1], body)
+	default:
+		writeJSON(w, http.StatusOK, operationResponse(op))
+	}
+}
+
+// list returns the items of the given collection, or a synthesized list if no
+// item has been created in it yet
+func (s *Server) list(w http.ResponseWriter, req *http.Request, r *route, op *openapi3.Operation, path string) {
+	content := operationContent(op)
+	items, ok := operationResponse(op).([]any)
+	if !ok {
+		writeJSON(w, http.StatusOK, operationResponse(op))
+		return
+	}
+
+	if c, ok := s.collections[path]; ok {
+		items = []any{}
+
+		var itemSchema *openapi3.SchemaRef
+		if content != nil && content.Schema != nil && content.Schema.Value != nil {
+			itemSchema = content.Schema.Value.Items
+		}
+		for _, id := range c.ids {
+			switch {
+			case itemSchema != nil && itemSchema.Value != nil && itemSchema.Value.Type.Is("object"):
+				items = append(items, c.objects[id])
+			case itemSchema != nil && itemSchema.Value != nil && itemSchema.Value.Type.Is("integer"):
+				value, _ := strconv.ParseInt(id, 10, 64)
+				items = append(items, value)
+			default:
+				items = append(items, id)
+			}
+		}
+	}
+
+	// Paginate the list using the X-Pagination-Cursor header
+	size := DefaultPageSize
+	if value, err := strconv.Atoi(req.Header.Get("X-Pagination-Size")); err == nil && value > 0 {
+		size = value
+	}
+	offset := 0
+	if cursor := req.Header.Get("X-Pagination-Cursor"); cursor != "" {
+		value, err := strconv.Atoi(cursor)
+		if err != nil || value < 0 || value > len(items) {
+			writeError(w, http.StatusBadRequest, "Client::BadRequest", fmt.Sprintf("Invalid pagination cursor %q", cursor))
+			return
+		}
+		offset = value
+	}
+	end := min(offset+size, len(items))
+	if end < len(items) {
+		w.Header().Set("X-Pagination-Cursor-Next", strconv.Itoa(end))
+	}
+
+	writeJSON(w, http.StatusOK, items[offset:end])
+}
+
+// create adds a new item to the given collection, built from the schema of the
+// items and the request body, and identified by a new ID unless the request
+// body defines it.
+func (s *Server) create(w http.ResponseWriter, r *route, op *openapi3.Operation, path string, body map[string]any) {
+	c, ok := s.collections[path]
+	if !ok {
+		c = &collection{objects: make(map[string]map[string]any)}
+		s.collections[path] = c
+	}
+
+	paramSchema := routeParamSchema(r.itemRoute, r.itemParam)
+
+	var id string
+	if value, ok := body[r.itemParam]; ok {
+		id = fmt.Sprint(value)
+	} else {
+		s.lastID++
+		switch {
+		case paramSchema != nil && paramSchema.Type.Is("integer"):
+			id = strconv.Itoa(s.lastID)
+		case paramSchema != nil && paramSchema.Format == "uuid":
+			id = fmt.Sprintf("00000000-0000-4000-8000-%012d", s.lastID)
+		default:
+			id = fmt.Sprintf("mock-%d", s.lastID)
+		}
+	}
+
+	if _, exists := c.objects[id]; exists {
+		writeError(w, http.StatusConflict, "Client::Conflict::AlreadyExists", fmt.Sprintf("The resource %q already exists", id))
+		return
+	}
+
+	object, _ := itemResponse(r.itemRoute).(map[string]any)
+	if object == nil {
+		object = make(map[string]any)
+	}
+	if err := utils.MergeMaps(object, body); err != nil {
+		writeError(w, http.StatusBadRequest, "Client::BadRequest", err.Error())
+		return
+	}
+
+	setID(object, r.itemParam, id, paramSchema)
+
+	c.ids = append(c.ids, id)
+	c.objects[id] = object
+
+	// Return the created item when the operation returns an item of the collection
+	if sameSchema(operationContent(op), operationContent(r.itemRoute.item.Get)) {
+		writeJSON(w, http.StatusOK, object)
+		return
+	}
+	writeJSON(w, http.StatusOK, operationResponse(op))
+}
+
+// handleItem answers the calls on the item of the given ID of a collection
+func (s *Server) handleItem(w http.ResponseWriter, req *http.Request, r *route, op *openapi3.Operation, path, id string, body map[string]any) {
+	c, tracked := s.collections[path]
+	var object map[string]any
+	if tracked {
+		object = c.objects[id]
+	}
+
+	if tracked && object == nil && req.Method != http.MethodPost {
+		writeError(w, http.StatusNotFound, "Client::NotFound", fmt.Sprintf("The requested object (%s = %s) does not exist", r.segments[len(r.segments)-1], id))
+		return
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		if object == nil {
+			response := operationResponse(op)
+			if synthesized, ok := response.(map[string]any); ok {
+				param := strings.Trim(r.segments[len(r.segments)-1], "{}")
+				setID(synthesized, param, id, routeParamSchema(r, param))
+			}
+			writeJSON(w, http.StatusOK, response)
+			return
+		}
+		writeJSON(w, http.StatusOK, object)
+
+	case http.MethodPut:
+		if object != nil {
+			if err := utils.MergeMaps(object, body); err != nil {
+				writeError(w, http.StatusBadRequest, "Client::BadRequest", err.Error())
+				return
+			}
+		}
+		writeJSON(w, http.StatusOK, operationResponse(op))
+
+	case http.MethodDelete:
+		if tracked {
+			delete(c.objects, id)
+			c.ids = slices.DeleteFunc(c.ids, func(value string) bool { return value == id })
+		} else {
+			// The collection is now tracked, so that the item is no longer listed
+			s.collections[path] = &collection{objects: make(map[string]map[string]any)}
+		}
+		writeJSON(w, http.StatusOK, operationResponse(op))
+
+	default:
+		writeJSON(w, http.StatusOK, operationResponse(op))
+	}
+}
+
+// setID sets the given ID to the fields of the object identifying it, typed
+// according to the schema of the path parameter
+func setID(object map[string]any, param, id string, paramSchema *openapi3.Schema) {
+	var value any = id
+	if paramSchema != nil && paramSchema.Type.Is("integer") {
+		value, _ = strconv.ParseInt(id, 10, 64)
+	}
+
+	for _, field := range []string{param, "id"} {
+		if _, ok := object[field]; ok {
+			object[field] = value
+		}
+	}
+}
+
+// findRoute returns the route matching the given path segments
+func (s *Server) findRoute(segments []string) *route {
+	for _, r := range s.routes {
+		if len(r.segments) != len(segments) {
+			continue
+		}
+
+		matches := true
+		for i, segment := range r.segments {
+			if !isParam(segment) && segment != segments[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return r
+		}
+	}
+	return nil
+}
+
+// operationContent returns the JSON content of the successful response of the given operation
+func operationContent(op *openapi3.Operation) *openapi3.MediaType {
+	if op == nil || op.Responses == nil {
+		return nil
+	}
+
+	response := op.Responses.Status(http.StatusOK)
+	if response == nil || response.Value == nil {
+		return nil
+	}
+	return response.Value.Content.Get("application/json")
+}
+
+func operationResponse(op *openapi3.Operation) any {
+	return responseExample(operationContent(op))
+}
+
+func itemResponse(r *route) any {
+	if r == nil {
+		return nil
+	}
+	return operationResponse(r.item.Get)
+}
+
+func sameSchema(a, b *openapi3.MediaType) bool {
+	if a == nil || b == nil || a.Schema == nil || b.Schema == nil {
+		return false
+	}
+	if a.Schema.Ref != "" {
+		return a.Schema.Ref == b.Schema.Ref
+	}
+	return a.Schema.Value == b.Schema.Value
+}
+
+// routeParamSchema returns the schema of the given path parameter of the route
+func routeParamSchema(r *route, name string) *openapi3.Schema {
+	if r == nil || r.item.Get == nil {
+		return nil
+	}
+
+	parameters := append(slices.Clone(r.item.Parameters), r.item.Get.Parameters...)
+	for _, param := range parameters {
+		if param.Value != nil && param.Value.In == "path" && param.Value.Name == name && param.Value.Schema != nil {
+			return param.Value.Schema.Value
+		}
+	}
+	return nil
+}
+
+func splitPath(path string) []string {
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(path, "/"), "/") {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segment = unescaped
+		}
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+func parentPath(segments []string) string {
+	return "/" + strings.Join(segments[:len(segments)-1], "/")
+}
+
+func isParam(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		log.Printf("failed to write response: %s", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, class, message string) {
+	writeJSON(w, status, map[string]string{
+		"class":   class,
+		"message": message,
+	})
+}
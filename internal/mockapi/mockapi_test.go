@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mockapi
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/maxatome/go-testdeep/td"
+)
+
+const testSchema = `{
+  "openapi": "3.0.0",
+  "info": {"title": "test", "version": "1.0"},
+  "servers": [{"url": "https://eu.api.ovh.com/1.0"}],
+  "paths": {
+    "/thing": {
+      "get": {"responses": {"200": {"description": "ok", "content": {"application/json": {"schema": {"type": "array", "items": {"type": "string"}}}}}}},
+      "post": {"responses": {"200": {"description": "ok", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Thing"}}}}}}
+    },
+    "/thing/available": {
+      "get": {"responses": {"200": {"description": "ok", "content": {"application/json": {"example": ["small"]}}}}}
+    },
+    "/thing/{thingId}": {
+      "parameters": [{"in": "path", "name": "thingId", "required": true, "schema": {"type": "string", "format": "uuid"}}],
+      "get": {"responses": {"200": {"description": "ok", "content": {"application/json": {"schema": {"$ref": "#/components/schemas/Thing"}}}}}},
+      "put": {"responses": {"200": {"description": "ok"}}},
+      "delete": {"responses": {"200": {"description": "ok"}}}
+    }
+  },
+  "components": {
+    "schemas": {
+      "Thing": {
+        "type": "object",
+        "properties": {
+          "id": {"type": "string", "format": "uuid"},
+          "name": {"type": "string"},
+          "size": {"type": "string", "enum": ["small", "large"]},
+          "count": {"type": "integer", "minimum": 1},
+          "createdAt": {"type": "string", "format": "date-time"},
+          "parent": {"$ref": "#/components/schemas/Thing"}
+        }
+      }
+    }
+  }
+}`
+
+func call(t *testing.T, server *httptest.Server, method, path, body string, headers ...string) (*http.Response, any) {
+	t.Helper()
+
+	req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+	td.Require(t).CmpNoError(err)
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	td.Require(t).CmpNoError(err)
+	defer resp.Body.Close()
+
+	content, _ := io.ReadAll(resp.Body)
+	var value any
+	td.Require(t).CmpNoError(json.Unmarshal(content, &value), string(content))
+	return resp, value
+}
+
+func TestServer(t *testing.T) {
+	s, err := NewServer([]byte(testSchema))
+	td.Require(t).CmpNoError(err)
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	// Responses are synthesized from the schemas until resources are created
+	_, value := call(t, server, http.MethodGet, "/v1/thing", "")
+	td.Cmp(t, value, []any{"string"})
+
+	_, value = call(t, server, http.MethodGet, "/v1/thing/available", "")
+	td.Cmp(t, value, []any{"small"})
+
+	_, value = call(t, server, http.MethodGet, "/v1/thing/abc", "")
+	td.Cmp(t, value, td.SuperMapOf(map[string]any{
+		"id":        "abc",
+		"name":      "string",
+		"size":      "small",
+		"count":     float64(1),
+		"createdAt": "2025-01-01T00:00:00Z",
+	}, nil))
+
+	// Created resources can be listed, fetched, updated and deleted
+	_, value = call(t, server, http.MethodPost, "/v1/thing", `{"name":"first"}`)
+	td.Cmp(t, value, td.SuperMapOf(map[string]any{
+		"id":   "00000000-0000-4000-8000-000000000001",
+		"name": "first",
+	}, nil))
+	call(t, server, http.MethodPost, "/v1/thing", `{"name":"second"}`)
+
+	_, value = call(t, server, http.MethodGet, "/v1/thing", "")
+	td.Cmp(t, value, []any{"00000000-0000-4000-8000-000000000001", "00000000-0000-4000-8000-000000000002"})
+
+	call(t, server, http.MethodPut, "/v1/thing/00000000-0000-4000-8000-000000000002", `{"size":"large"}`)
+	_, value = call(t, server, http.MethodGet, "/v1/thing/00000000-0000-4000-8000-000000000002", "")
+	td.Cmp(t, value, td.SuperMapOf(map[string]any{"name": "second", "size": "large"}, nil))
+
+	// Lists are paginated using cursors
+	resp, value := call(t, server, http.MethodGet, "/v1/thing", "", "X-Pagination-Size", "1")
+	td.Cmp(t, value, []any{"00000000-0000-4000-8000-000000000001"})
+	td.Cmp(t, resp.Header.Get("X-Pagination-Cursor-Next"), "1")
+
+	resp, value = call(t, server, http.MethodGet, "/v1/thing", "", "X-Pagination-Size", "1", "X-Pagination-Cursor", "1")
+	td.Cmp(t, value, []any{"00000000-0000-4000-8000-000000000002"})
+	td.Cmp(t, resp.Header.Get("X-Pagination-Cursor-Next"), "")
+
+	call(t, server, http.MethodDelete, "/v1/thing/00000000-0000-4000-8000-000000000001", "")
+	_, value = call(t, server, http.MethodGet, "/v1/thing", "")
+	td.Cmp(t, value, []any{"00000000-0000-4000-8000-000000000002"})
+
+	resp, value = call(t, server, http.MethodGet, "/v1/thing/00000000-0000-4000-8000-000000000001", "")
+	td.Cmp(t, resp.StatusCode, http.StatusNotFound)
+	td.Cmp(t, value, td.SuperMapOf(map[string]any{"class": "Client::NotFound"}, nil))
+
+	// Unknown paths and methods are rejected
+	resp, _ = call(t, server, http.MethodGet, "/v1/unknown", "")
+	td.Cmp(t, resp.StatusCode, http.StatusNotFound)
+	resp, _ = call(t, server, http.MethodPatch, "/v1/thing", "")
+	td.Cmp(t, resp.StatusCode, http.StatusMethodNotAllowed)
+}
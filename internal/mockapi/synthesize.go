@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mockapi
+
+import (
+	"slices"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// maxSynthesizeDepth limits the depth of the synthesized values, schemas
+// being allowed to reference themselves
+const maxSynthesizeDepth = 8
+
+// responseExample returns the example of the given response content if the
+// schema documents one, or a value synthesized from its schema.
+func responseExample(content *openapi3.MediaType) any {
+	if content == nil {
+		return nil
+	}
+
+	if content.Example != nil {
+		return content.Example
+	}
+
+	// Use the first example by name, to always serve the same one
+	names := make([]string, 0, len(content.Examples))
+	for name, example := range content.Examples {
+		if example != nil && example.Value != nil {
+			names = append(names, name)
+		}
+	}
+	if len(names) > 0 {
+		slices.Sort(names)
+		return content.Examples[names[0]].Value.Value
+	}
+
+	return synthesize(content.Schema, 0)
+}
+
+// synthesize returns a value valid against the given schema: its example or
+// default value when defined, the first allowed value of enums, or a value
+// built from its type and format.
+func synthesize(ref *openapi3.SchemaRef, depth int) any {
+	if ref == nil || ref.Value == nil || depth > maxSynthesizeDepth {
+		return nil
+	}
+	schema := ref.Value
+
+	switch {
+	case schema.Example != nil:
+		return schema.Example
+	case schema.Default != nil:
+		return schema.Default
+	case len(schema.Enum) > 0:
+		return schema.Enum[0]
+	case len(schema.AllOf) > 0:
+		merged := map[string]any{}
+		for _, sub := range schema.AllOf {
+			if object, ok := synthesize(sub, depth+1).(map[string]any); ok {
+				for key, value := range object {
+					merged[key] = value
+				}
+			}
+		}
+		for key, value := range synthesizeProperties(schema, depth) {
+			merged[key] = value
+		}
+		return merged
+	case len(schema.OneOf) > 0:
+		return synthesize(schema.OneOf[0], depth+1)
+	case len(schema.AnyOf) > 0:
+		return synthesize(schema.AnyOf[0], depth+1)
+	}
+
+	switch {
+	case schema.Type.Is("string"):
+		return synthesizeString(schema)
+	case schema.Type.Is("integer"):
+		if schema.Min != nil {
+			return int64(*schema.Min)
+		}
+		return int64(0)
+	case schema.Type.Is("number"):
+		if schema.Min != nil {
+			return *schema.Min
+		}
+		return float64(0)
+	case schema.Type.Is("boolean"):
+		return false
+	case schema.Type.Is("array"):
+		item := synthesize(schema.Items, depth+1)
+		if item == nil {
+			return []any{}
+		}
+		return []any{item}
+	case schema.Type.Is("object"), len(schema.Properties) > 0:
+		return synthesizeProperties(schema, depth)
+	}
+
+	return nil
+}
+
+// synthesizeProperties returns an object with a synthesized value for each of
+// the properties of the given schema
+func synthesizeProperties(schema *openapi3.Schema, depth int) map[string]any {
+	object := make(map[string]any, len(schema.Properties))
+	for name, property := range schema.Properties {
+		if value := synthesize(property, depth+1); value != nil {
+			object[name] = value
+		}
+	}
+	return object
+}
+
+func synthesizeString(schema *openapi3.Schema) string {
+	switch schema.Format {
+	case "date-time":
+		return time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC).Format(time.RFC3339)
+	case "date":
+		return "2025-01-01"
+	case "time":
+		return "00:00:00"
+	case "uuid":
+		return "00000000-0000-4000-8000-000000000000"
+	case "ipv4", "ip":
+		return "192.0.2.1"
+	case "ipv6":
+		return "2001:db8::1"
+	case "ipBlock", "ipv4Block":
+		return "192.0.2.0/24"
+	case "ipv6Block":
+		return "2001:db8::/64"
+	case "email":
+		return "user@example.com"
+	case "uri", "url":
+		return "https://example.com"
+	case "password":
+		return "password"
+	}
+
+	if schema.MinLength > 6 {
+		value := make([]byte, schema.MinLength)
+		for i := range value {
+			value[i] = 'x'
+		}
+		return string(value)
+	}
+	return "string"
+}
@@ -8,17 +8,31 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/ovh/ovhcloud-cli/internal/utils"
 )
 
+// loadedSpecs caches the parsed specs, keyed by the address of their data
+var loadedSpecs sync.Map
+
+type loadedSpec struct {
+	doc *openapi3.T
+	err error
+}
+
 func FilterEditableFields(spec []byte, path, method string, body map[string]any) (map[string]any, error) {
 	content, err := getRequestBodyFromSpec(spec, path, method)
 	if err != nil {
 		return nil, err
 	}
 
+	if content == nil || content.Schema == nil || content.Schema.Value == nil {
+		return nil, fmt.Errorf("no JSON request body schema for %s %s", method, path)
+	}
+
 	// Prune unknown fields
 	pruned := pruneUnknownFields(body, content.Schema.Value)
 
@@ -31,6 +45,10 @@ func GetOperationRequestExamples(spec []byte, path, method, defaultExample strin
 		return nil, err
 	}
 
+	if content == nil {
+		return nil, fmt.Errorf("no JSON request body for %s %s", method, path)
+	}
+
 	jsonExamples := make(map[string][]byte, len(content.Examples)+1)
 	for k, v := range content.Examples {
 		// Marshal & unmarshal example to get the request
@@ -70,15 +88,67 @@ func GetOperationRequestExamples(spec []byte, path, method, defaultExample strin
 	return examples, nil
 }
 
+// GetRequestBodyPropertyEnum returns the allowed values of the given property of the
+// request body of an operation. Nested properties are given using dots (e.g. "subnet.ipVersion").
+func GetRequestBodyPropertyEnum(spec []byte, path, method, property string) ([]string, error) {
+	content, err := getRequestBodyFromSpec(spec, path, method)
+	if err != nil {
+		return nil, err
+	}
+
+	if content == nil || content.Schema == nil || content.Schema.Value == nil {
+		return nil, fmt.Errorf("no JSON request body schema for %s %s", method, path)
+	}
+
+	schema := content.Schema.Value
+	for _, name := range strings.Split(property, ".") {
+		if len(schema.AllOf) > 0 {
+			schema = schema.AllOf[0].Value
+		}
+		propSchema, ok := schema.Properties[name]
+		if !ok || propSchema.Value == nil {
+			return nil, fmt.Errorf("property %q not found in request body of %s %s", property, method, path)
+		}
+		schema = propSchema.Value
+	}
+
+	values := make([]string, 0, len(schema.Enum))
+	for _, value := range schema.Enum {
+		values = append(values, fmt.Sprint(value))
+	}
+
+	return values, nil
+}
+
+// loadSpec parses and validates the given spec, only once for each spec
+func loadSpec(spec []byte) (*openapi3.T, error) {
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("failed to load spec: empty spec")
+	}
+
+	key := &spec[0]
+	if loaded, ok := loadedSpecs.Load(key); ok {
+		return loaded.(loadedSpec).doc, loaded.(loadedSpec).err
+	}
+
+	var loaded loadedSpec
+	loader := openapi3.NewLoader()
+	loaded.doc, loaded.err = loader.LoadFromData(spec)
+	if loaded.err != nil {
+		loaded.doc, loaded.err = nil, fmt.Errorf("failed to load spec: %w", loaded.err)
+	} else if err := loaded.doc.Validate(context.Background()); err != nil {
+		loaded.doc, loaded.err = nil, fmt.Errorf("failed to validate spec: %w", err)
+	}
+
+	actual, _ := loadedSpecs.LoadOrStore(key, loaded)
+	return actual.(loadedSpec).doc, actual.(loadedSpec).err
+}
+
 func getRequestBodyFromSpec(spec []byte, path, method string) (*openapi3.MediaType, error) {
 	// Load the OpenAPI spec
-	loader := openapi3.NewLoader()
-	doc, err := loader.LoadFromData(spec)
+	doc, err := loadSpec(spec)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load spec: %w", err)
-	}
-	if err = doc.Validate(context.Background()); err != nil {
-		return nil, fmt.Errorf("failed to validate spec: %w", err)
+		return nil, err
 	}
 
 	// Retrieve operation
@@ -105,9 +175,11 @@ func getRequestBodyFromSpec(spec []byte, path, method string) (*openapi3.MediaTy
 	}
 
 	// Get request body
-	reqBody := op.RequestBody.Value
+	if op.RequestBody == nil || op.RequestBody.Value == nil {
+		return nil, fmt.Errorf("operation %s %s has no request body", method, path)
+	}
 
-	return reqBody.Content["application/json"], nil
+	return op.RequestBody.Value.Content["application/json"], nil
 }
 
 // pruneUnknownFields recursively removes fields not in the schema
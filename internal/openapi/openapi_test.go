@@ -440,3 +440,126 @@ func TestPruneUnknownFields(t *testing.T) {
 		})
 	})
 }
+
+func TestGetRequestBodyPropertyEnum(t *testing.T) {
+	spec := []byte(`{
+	  "openapi": "3.0.0",
+	  "info": { "title": "Test API", "version": "1.0.0" },
+	  "paths": {
+		"/record": {
+		  "post": {
+			"requestBody": {
+			  "content": {
+				"application/json": {
+				  "schema": { "$ref": "#/components/schemas/Record" }
+				}
+			  }
+			},
+			"responses": {
+			  "200": {
+				"description": "OK"
+			  }
+			}
+		  }
+		}
+	  },
+	  "components": {
+		"schemas": {
+		  "Record": {
+			"type": "object",
+			"properties": {
+			  "fieldType": { "$ref": "#/components/schemas/RecordType" },
+			  "target": {
+				"type": "object",
+				"properties": {
+				  "ipVersion": { "type": "integer", "enum": [4, 6] }
+				}
+			  }
+			}
+		  },
+		  "RecordType": { "type": "string", "enum": ["A", "AAAA", "CNAME"] }
+		}
+	  }
+	}`)
+
+	values, err := GetRequestBodyPropertyEnum(spec, "/record", "post", "fieldType")
+	td.CmpNoError(t, err)
+	td.Cmp(t, values, []string{"A", "AAAA", "CNAME"})
+
+	values, err = GetRequestBodyPropertyEnum(spec, "/record", "post", "target.ipVersion")
+	td.CmpNoError(t, err)
+	td.Cmp(t, values, []string{"4", "6"})
+
+	_, err = GetRequestBodyPropertyEnum(spec, "/record", "post", "unknown")
+	td.CmpString(t, err, `property "unknown" not found in request body of post /record`)
+}
+
+func TestGetRequestBodyFromSpecWithoutBody(t *testing.T) {
+	spec := []byte(`{
+	  "openapi": "3.0.0",
+	  "info": { "title": "Test API", "version": "1.0.0" },
+	  "paths": {
+		"/reboot": {
+		  "post": {
+			"responses": {
+			  "200": {
+				"description": "OK"
+			  }
+			}
+		  }
+		},
+		"/upload": {
+		  "put": {
+			"requestBody": {
+			  "content": {
+				"application/octet-stream": {}
+			  }
+			},
+			"responses": {
+			  "200": {
+				"description": "OK"
+			  }
+			}
+		  }
+		}
+	  }
+	}`)
+
+	_, err := FilterEditableFields(spec, "/reboot", "post", map[string]any{})
+	td.CmpString(t, err, "operation post /reboot has no request body")
+
+	_, err = GetRequestBodyPropertyEnum(spec, "/reboot", "post", "type")
+	td.CmpString(t, err, "operation post /reboot has no request body")
+
+	_, err = FilterEditableFields(spec, "/upload", "put", map[string]any{})
+	td.CmpString(t, err, "no JSON request body schema for put /upload")
+
+	_, err = GetRequestBodyPropertyEnum(spec, "/upload", "put", "type")
+	td.CmpString(t, err, "no JSON request body schema for put /upload")
+
+	_, err = GetOperationRequestExamples(spec, "/upload", "put", "", nil)
+	td.CmpString(t, err, "no JSON request body for put /upload")
+}
+
+func TestLoadSpecOnce(t *testing.T) {
+	spec := []byte(`{
+	  "openapi": "3.0.0",
+	  "info": { "title": "Test API", "version": "1.0.0" },
+	  "paths": {}
+	}`)
+
+	doc, err := loadSpec(spec)
+	td.CmpNoError(t, err)
+
+	// The same spec is not parsed again
+	again, err := loadSpec(spec)
+	td.CmpNoError(t, err)
+	td.CmpShallow(t, again, doc)
+
+	// Loading errors are kept too
+	invalid := []byte(`{"openapi": "3.0.0", "paths": []}`)
+	_, err = loadSpec(invalid)
+	td.CmpContains(t, err, "failed to load spec")
+	_, err = loadSpec(invalid)
+	td.CmpContains(t, err, "failed to load spec")
+}
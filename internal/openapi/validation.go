@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ValidationError is a field of a request body not matching its schema
+type ValidationError struct {
+	// Path of the field, using the same notation as the diff of edits (e.g. subnet.ipPools[0].start)
+	Path    string
+	Message string
+}
+
+// ValidationErrors is the list of the fields of a request body not matching its schema
+type ValidationErrors []ValidationError
+
+func (errs ValidationErrors) Error() string {
+	var message strings.Builder
+	message.WriteString("invalid request body:")
+	for _, err := range errs {
+		path := err.Path
+		if path == "" {
+			path = "(body)"
+		}
+		fmt.Fprintf(&message, "\n  - %s: %s", path, err.Message)
+	}
+	return message.String()
+}
+
+// ValidateRequestBody checks the given body against the request body schema of the
+// given operation. It returns ValidationErrors listing all the invalid fields.
+func ValidateRequestBody(spec []byte, path, method string, body map[string]any) error {
+	content, err := getRequestBodyFromSpec(spec, path, method)
+	if err != nil {
+		return err
+	}
+	if content == nil || content.Schema == nil || content.Schema.Value == nil {
+		return nil
+	}
+
+	// Use the types produced by encoding/json, expected by the validation
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("failed to unmarshal request body: %w", err)
+	}
+
+	err = content.Schema.Value.VisitJSON(value, openapi3.MultiErrors(), openapi3.VisitAsRequest())
+	if err == nil {
+		return nil
+	}
+
+	var errs ValidationErrors
+	collectValidationErrors(err, &errs)
+	if len(errs) == 0 {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+
+	slices.SortStableFunc(errs, func(a, b ValidationError) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return slices.Compact(errs)
+}
+
+func collectValidationErrors(err error, errs *ValidationErrors) {
+	var multiErr openapi3.MultiError
+	if errors.As(err, &multiErr) {
+		for _, e := range multiErr {
+			collectValidationErrors(e, errs)
+		}
+		return
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		*errs = append(*errs, ValidationError{
+			Path:    formatJSONPointer(schemaErr.JSONPointer()),
+			Message: schemaErrorMessage(schemaErr),
+		})
+	}
+}
+
+// schemaErrorMessage describes the given error with the expected type or
+// the allowed values of the field
+func schemaErrorMessage(err *openapi3.SchemaError) string {
+	switch err.SchemaField {
+	case "type":
+		if err.Schema != nil && err.Schema.Type != nil {
+			return fmt.Sprintf("expected type %s, got %s", strings.Join(err.Schema.Type.Slice(), " or "), jsonTypeName(err.Value))
+		}
+	case "enum":
+		if err.Schema != nil {
+			allowed := make([]string, 0, len(err.Schema.Enum))
+			for _, value := range err.Schema.Enum {
+				encoded, _ := json.Marshal(value)
+				allowed = append(allowed, string(encoded))
+			}
+			value, _ := json.Marshal(err.Value)
+			return fmt.Sprintf("invalid value %s, allowed values are %s", value, strings.Join(allowed, ", "))
+		}
+	case "required":
+		return "required field is missing"
+	case "nullable":
+		return "null is not allowed"
+	case "readOnly":
+		return "field is read-only"
+	}
+
+	return err.Reason
+}
+
+func formatJSONPointer(pointer []string) string {
+	var path strings.Builder
+	for _, segment := range pointer {
+		if isIndex(segment) {
+			fmt.Fprintf(&path, "[%s]", segment)
+			continue
+		}
+		if path.Len() > 0 {
+			path.WriteByte('.')
+		}
+		path.WriteString(segment)
+	}
+	return path.String()
+}
+
+func isIndex(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	for _, c := range segment {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func jsonTypeName(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64, json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/maxatome/go-testdeep/td"
+)
+
+func TestValidateRequestBody(t *testing.T) {
+	spec := []byte(`{
+	  "openapi": "3.0.0",
+	  "info": { "title": "Test API", "version": "1.0.0" },
+	  "paths": {
+		"/network": {
+		  "post": {
+			"requestBody": {
+			  "content": {
+				"application/json": {
+				  "schema": {
+					"type": "object",
+					"required": ["name"],
+					"properties": {
+					  "name": { "type": "string" },
+					  "vlanId": { "type": "integer" },
+					  "subnet": {
+						"type": "object",
+						"properties": {
+						  "ipVersion": { "type": "integer", "enum": [4, 6] },
+						  "pools": {
+							"type": "array",
+							"items": {
+							  "type": "object",
+							  "properties": {
+								"region": { "type": "string", "enum": ["GRA9", "SBG5"] }
+							  }
+							}
+						  }
+						}
+					  }
+					}
+				  }
+				}
+			  }
+			},
+			"responses": {
+			  "200": {
+				"description": "OK"
+			  }
+			}
+		  }
+		}
+	  }
+	}`)
+
+	t.Run("valid body", func(t *testing.T) {
+		err := ValidateRequestBody(spec, "/network", "post", map[string]any{
+			"name":   "my-network",
+			"vlanId": 42,
+			"subnet": map[string]any{
+				"ipVersion": 4,
+				"pools":     []any{map[string]any{"region": "GRA9"}},
+			},
+		})
+		td.CmpNoError(t, err)
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		err := ValidateRequestBody(spec, "/network", "post", map[string]any{
+			"vlanId": "42",
+			"subnet": map[string]any{
+				"ipVersion": 5,
+				"pools":     []any{map[string]any{"region": "BHS5"}},
+			},
+		})
+		td.Cmp(t, err, td.Isa(ValidationErrors{}))
+		td.Cmp(t, err, ValidationErrors{
+			{Path: "name", Message: "required field is missing"},
+			{Path: "subnet.ipVersion", Message: "invalid value 5, allowed values are 4, 6"},
+			{Path: "subnet.pools[0].region", Message: `invalid value "BHS5", allowed values are "GRA9", "SBG5"`},
+			{Path: "vlanId", Message: "expected type integer, got string"},
+		})
+		td.CmpString(t, err, `invalid request body:
+  - name: required field is missing
+  - subnet.ipVersion: invalid value 5, allowed values are 4, 6
+  - subnet.pools[0].region: invalid value "BHS5", allowed values are "GRA9", "SBG5"
+  - vlanId: expected type integer, got string`)
+	})
+
+	t.Run("unknown path", func(t *testing.T) {
+		err := ValidateRequestBody(spec, "/unknown", "post", map[string]any{})
+		td.CmpString(t, err, `path "/unknown" not found in spec`)
+	})
+}
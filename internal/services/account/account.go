@@ -34,12 +34,18 @@ var (
 	}
 )
 
+func init() {
+	display.RegisterTemplates("account", map[string]*string{
+		"me": &meTemplate,
+	})
+}
+
 func GetMe(_ *cobra.Command, _ []string) {
 	common.ManageObjectRequest("/me", "", meTemplate)
 }
 
-func ListSSHKeys(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/me/sshKey", "", sshKeysColumnsToDisplay, flags.GenericFilters)
+func ListSSHKeys(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/me/sshKey", "", sshKeysColumnsToDisplay, flags.GenericFilters)
 }
 
 func CreateOAuth2Client(cmd *cobra.Command, args []string) {
@@ -66,9 +72,9 @@ func CreateOAuth2Client(cmd *cobra.Command, args []string) {
 	)
 }
 
-func ListOAuth2Clients(_ *cobra.Command, _ []string) {
+func ListOAuth2Clients(cmd *cobra.Command, _ []string) {
 	endpoint := "/v1/me/api/oauth2/client"
-	common.ManageListRequest(endpoint, "", []string{"clientId", "name", "description", "flow", "createdAt"}, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), endpoint, "", []string{"clientId", "name", "description", "flow", "createdAt"}, flags.GenericFilters)
 }
 
 func GetOauth2Client(cmd *cobra.Command, args []string) {
@@ -7,6 +7,7 @@ package alldom
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
@@ -19,8 +20,14 @@ var (
 	alldomTemplate string
 )
 
-func ListAllDom(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/allDom", "", alldomColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("alldom", map[string]*string{
+		"alldom": &alldomTemplate,
+	})
+}
+
+func ListAllDom(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/allDom", "", alldomColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetAllDom(_ *cobra.Command, args []string) {
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	filtersLib "github.com/ovh/ovhcloud-cli/internal/filters"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	allowedMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete}
+
+	// columnNameRegexp matches the object keys that can be used as
+	// table columns (they must be valid gval identifiers)
+	columnNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+func CallAPI(cmd *cobra.Command, args []string) {
+	method := strings.ToUpper(args[0])
+	if !slices.Contains(allowedMethods, method) {
+		display.OutputError(&flags.OutputFormatConfig, "invalid HTTP method %q, allowed methods are: %s", args[0], strings.Join(allowedMethods, ", "))
+		return
+	}
+
+	path := args[1]
+	if !strings.HasPrefix(path, "/") {
+		display.OutputError(&flags.OutputFormatConfig, "invalid path %q, it must start with a '/' (e.g. /v1/me)", path)
+		return
+	}
+
+	var (
+		result any
+		err    error
+	)
+
+	switch method {
+	case http.MethodGet:
+		result, err = httpLib.FetchRaw(cmd.Context(), path)
+	case http.MethodDelete:
+		err = httpLib.Client.Delete(path, &result)
+	default:
+		var parameters map[string]any
+		parameters, err = common.ReadInputParameters(nil)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to read request body: %s", err)
+			return
+		}
+
+		out, marshalErr := json.MarshalIndent(parameters, "", " ")
+		if marshalErr != nil {
+			display.OutputError(&flags.OutputFormatConfig, "request body cannot be marshalled: %s", marshalErr)
+			return
+		}
+		log.Println("Request body: \n" + string(out))
+
+		err = httpLib.Client.CallAPI(method, path, parameters, &result, true)
+	}
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "error calling %s %s: %s", method, path, err)
+		return
+	}
+
+	switch value := result.(type) {
+	case []any:
+		// Arrays of scalar values (e.g. lists of IDs) are converted to objects
+		// having a single "value" field, so that they can be filtered
+		objects := make([]map[string]any, 0, len(value))
+		for _, item := range value {
+			if object, ok := item.(map[string]any); ok {
+				objects = append(objects, object)
+			} else {
+				objects = append(objects, map[string]any{"value": item})
+			}
+		}
+
+		objects, err := filtersLib.FilterLines(objects, flags.GenericFilters)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
+			return
+		}
+
+		display.RenderTable(objects, getColumnsToDisplay(objects), &flags.OutputFormatConfig)
+	case map[string]any:
+		display.OutputObject(value, path, "", &flags.OutputFormatConfig)
+	case nil:
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ %s %s done", method, path)
+	default:
+		display.OutputInfo(&flags.OutputFormatConfig, value, "%v", value)
+	}
+}
+
+// getColumnsToDisplay returns the sorted list of keys having a scalar
+// value in the given objects, to be used as table columns.
+func getColumnsToDisplay(objects []map[string]any) []string {
+	var columns []string
+
+	for _, object := range objects {
+		for key, value := range object {
+			switch value.(type) {
+			case map[string]any, []any:
+				continue
+			}
+
+			if columnNameRegexp.MatchString(key) && !slices.Contains(columns, key) {
+				columns = append(columns, key)
+			}
+		}
+	}
+	slices.Sort(columns)
+
+	return columns
+}
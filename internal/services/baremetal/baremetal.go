@@ -5,6 +5,8 @@
 package baremetal
 
 import (
+	"cmp"
+	"context"
 	_ "embed"
 	"fmt"
 	"io"
@@ -22,6 +24,7 @@ import (
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/ovh/ovhcloud-cli/internal/wait"
 	"github.com/spf13/cobra"
 )
 
@@ -76,16 +79,22 @@ var (
 	}
 )
 
-func ListBaremetal(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/dedicated/server", "", baremetalColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("baremetal", map[string]*string{
+		"baremetal": &baremetalTemplate,
+	})
 }
 
-func ListBaremetalTasks(_ *cobra.Command, args []string) {
+func ListBaremetal(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/dedicated/server", "", baremetalColumnsToDisplay, flags.GenericFilters)
+}
+
+func ListBaremetalTasks(cmd *cobra.Command, args []string) {
 	url := fmt.Sprintf("/v1/dedicated/server/%s/task", args[0])
-	common.ManageListRequest(url, "", []string{"taskId", "function", "status", "startDate", "doneDate"}, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), url, "", []string{"taskId", "function", "status", "startDate", "doneDate"}, flags.GenericFilters)
 }
 
-func GetBaremetal(_ *cobra.Command, args []string) {
+func GetBaremetal(cmd *cobra.Command, args []string) {
 	path := fmt.Sprintf("/v1/dedicated/server/%s", url.PathEscape(args[0]))
 
 	// Fetch dedicated server
@@ -97,7 +106,7 @@ func GetBaremetal(_ *cobra.Command, args []string) {
 
 	// Fetch running tasks
 	path = fmt.Sprintf("/v1/dedicated/server/%s/task", url.PathEscape(args[0]))
-	tasks, err := httpLib.FetchExpandedArray(path, "")
+	tasks, err := httpLib.FetchExpandedArray(cmd.Context(), path, "")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "error fetching tasks for %s: %s", args[0], err)
 		return
@@ -124,6 +133,27 @@ func GetBaremetal(_ *cobra.Command, args []string) {
 	display.OutputObject(object, args[0], baremetalTemplate, &flags.OutputFormatConfig)
 }
 
+// BaremetalWaitFailureCondition is the default condition stopping the wait of a baremetal
+const BaremetalWaitFailureCondition = `state in ["error", "hacked", "hackedBlocked"]`
+
+func WaitBaremetal(cmd *cobra.Command, args []string) {
+	result, err := wait.ForCondition(
+		cmd.Context(),
+		fmt.Sprintf("baremetal %s", args[0]),
+		fmt.Sprintf("/v1/dedicated/server/%s", url.PathEscape(args[0])),
+		"state",
+		flags.WaitCondition,
+		cmp.Or(flags.WaitFailureCondition, BaremetalWaitFailureCondition),
+		wait.Options{Timeout: time.Hour, PollInterval: 15 * time.Second},
+	)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for baremetal: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Baremetal %s matches condition %s", args[0], flags.WaitCondition)
+}
+
 func EditBaremetal(cmd *cobra.Command, args []string) {
 	if err := common.EditResource(
 		cmd,
@@ -137,15 +167,26 @@ func EditBaremetal(cmd *cobra.Command, args []string) {
 	}
 }
 
-func RebootBaremetal(_ *cobra.Command, args []string) {
+func RebootBaremetal(cmd *cobra.Command, args []string) {
 	url := fmt.Sprintf("/v1/dedicated/server/%s/reboot", url.PathEscape(args[0]))
 
-	if err := httpLib.Client.Post(url, nil, nil); err != nil {
+	var task map[string]any
+	if err := httpLib.Client.Post(url, nil, &task); err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "error rebooting server %s: %s", args[0], err)
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Reboot launched…")
+	if !flags.WaitForTask {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Reboot launched…")
+		return
+	}
+
+	if err := waitForDedicatedServerTask(cmd.Context(), args[0], task["taskId"]); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for server to be rebooted: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Server %s rebooted", args[0])
 }
 
 func RebootRescueBaremetal(cmd *cobra.Command, args []string) {
@@ -185,7 +226,7 @@ func RebootRescueBaremetal(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	if err := waitForDedicatedServerTask(args[0], task["taskId"]); err != nil {
+	if err := waitForDedicatedServerTask(cmd.Context(), args[0], task["taskId"]); err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to wait for server to be rebooted: %s", err)
 		return
 	}
@@ -196,31 +237,23 @@ func RebootRescueBaremetal(cmd *cobra.Command, args []string) {
 	GetBaremetalAuthenticationSecrets(cmd, args)
 }
 
-func waitForDedicatedServerTask(serviceName string, taskID any) error {
+func waitForDedicatedServerTask(ctx context.Context, serviceName string, taskID any) error {
 	endpoint := fmt.Sprintf("/v1/dedicated/server/%s/task/%s", url.PathEscape(serviceName), taskID)
 
-	for retry := 0; retry < 100; retry++ {
-		var task map[string]any
-
-		if err := httpLib.Client.Get(endpoint, &task); err != nil {
-			return fmt.Errorf("failed to fetch task: %w", err)
-		}
-
-		switch task["status"] {
-		case "done":
-			return nil
-		case "todo", "init", "doing":
-			log.Printf("Still waiting for task to complete (status=%s)…", task["status"])
-			time.Sleep(30 * time.Second)
-		default:
-			return fmt.Errorf("invalid state for task %d: %s", taskID, task["status"])
-		}
-	}
+	_, err := wait.ForResourceStatus(
+		ctx,
+		fmt.Sprintf("task %s", taskID),
+		endpoint,
+		"status",
+		[]string{"done"},
+		[]string{"cancelled", "customerError", "ovhError"},
+		wait.Options{Timeout: time.Hour, PollInterval: 15 * time.Second},
+	)
 
-	return fmt.Errorf("timeout waiting for task %d to be completed", taskID)
+	return err
 }
 
-func BaremetalGetIPMIAccess(_ *cobra.Command, args []string) {
+func BaremetalGetIPMIAccess(cmd *cobra.Command, args []string) {
 	path := fmt.Sprintf("/v1/dedicated/server/%s/features/ipmi/access", url.PathEscape(args[0]))
 
 	parameters := map[string]any{
@@ -240,7 +273,7 @@ func BaremetalGetIPMIAccess(_ *cobra.Command, args []string) {
 		return
 	}
 
-	if err := waitForDedicatedServerTask(args[0], task["taskId"]); err != nil {
+	if err := waitForDedicatedServerTask(cmd.Context(), args[0], task["taskId"]); err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed waiting for task: %s", err)
 		return
 	}
@@ -261,10 +294,10 @@ func BaremetalGetIPMIAccess(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, accessDetails, "%s", output)
 }
 
-func ListBaremetalInterventions(_ *cobra.Command, args []string) {
+func ListBaremetalInterventions(cmd *cobra.Command, args []string) {
 	path := fmt.Sprintf("/v1/dedicated/server/%s/intervention", args[0])
 
-	interventions, err := httpLib.FetchExpandedArray(path, "")
+	interventions, err := httpLib.FetchExpandedArray(cmd.Context(), path, "")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch past interventions: %s", err)
 		return
@@ -275,7 +308,7 @@ func ListBaremetalInterventions(_ *cobra.Command, args []string) {
 	}
 
 	path = fmt.Sprintf("/v1/dedicated/server/%s/plannedIntervention", args[0])
-	plannedInterventions, err := httpLib.FetchExpandedArray(path, "")
+	plannedInterventions, err := httpLib.FetchExpandedArray(cmd.Context(), path, "")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch planned interventions: %s", err)
 		return
@@ -296,10 +329,10 @@ func ListBaremetalInterventions(_ *cobra.Command, args []string) {
 	display.RenderTable(plannedInterventions, []string{"type", "date", "status"}, &flags.OutputFormatConfig)
 }
 
-func ListBaremetalBoots(_ *cobra.Command, args []string) {
+func ListBaremetalBoots(cmd *cobra.Command, args []string) {
 	path := fmt.Sprintf("/v1/dedicated/server/%s/boot", url.PathEscape(args[0]))
 
-	boots, err := httpLib.FetchExpandedArray(path, "")
+	boots, err := httpLib.FetchExpandedArray(cmd.Context(), path, "")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "error fetching boot options for server %q: %s", args[0], err)
 		return
@@ -308,7 +341,7 @@ func ListBaremetalBoots(_ *cobra.Command, args []string) {
 	for _, boot := range boots {
 		path = fmt.Sprintf("/v1/dedicated/server/%s/boot/%s/option", url.PathEscape(args[0]), boot["bootId"])
 
-		options, err := httpLib.FetchExpandedArray(path, "")
+		options, err := httpLib.FetchExpandedArray(cmd.Context(), path, "")
 		if err != nil {
 			display.OutputError(&flags.OutputFormatConfig, "error fetching options of boot %d for server %s: %s", boot["bootId"], args[0], err)
 			return
@@ -384,9 +417,9 @@ func SetBaremetalBootScript(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Boot script correctly configured")
 }
 
-func ListBaremetalVNIs(_ *cobra.Command, args []string) {
+func ListBaremetalVNIs(cmd *cobra.Command, args []string) {
 	url := fmt.Sprintf("/v1/dedicated/server/%s/virtualNetworkInterface", args[0])
-	common.ManageListRequest(url, "", []string{"uuid", "name", "mode", "vrack", "enabled"}, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), url, "", []string{"uuid", "name", "mode", "vrack", "enabled"}, flags.GenericFilters)
 }
 
 func CreateBaremetalOLAAggregation(_ *cobra.Command, args []string) {
@@ -450,7 +483,7 @@ func ReinstallBaremetal(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	if err := waitForDedicatedServerTask(args[0], task["taskId"]); err != nil {
+	if err := waitForDedicatedServerTask(cmd.Context(), args[0], task["taskId"]); err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to wait for server to be reinstalled: %s", err)
 		return
 	}
@@ -461,7 +494,7 @@ func ReinstallBaremetal(cmd *cobra.Command, args []string) {
 	GetBaremetalAuthenticationSecrets(cmd, args)
 }
 
-func GetBaremetalRelatedIPs(_ *cobra.Command, args []string) {
+func GetBaremetalRelatedIPs(cmd *cobra.Command, args []string) {
 	path := fmt.Sprintf("/v1/ip?routedTo.serviceName=%s", url.QueryEscape(args[0]))
 
 	var ips []any
@@ -470,7 +503,7 @@ func GetBaremetalRelatedIPs(_ *cobra.Command, args []string) {
 		return
 	}
 
-	ipsExpanded, err := httpLib.FetchObjectsParallel[map[string]any]("/ip/%s", ips, flags.IgnoreErrors)
+	ipsExpanded, err := httpLib.FetchObjectsParallel[map[string]any](cmd.Context(), "/ip/%s", ips, flags.IgnoreErrors)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch objects for each IP: %s", err)
 		return
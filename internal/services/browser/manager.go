@@ -5823,7 +5823,7 @@ func (m Model) launchCreationWizard() tea.Cmd {
 // setDefaultProject saves the project ID as the default cloud project
 func (m Model) setDefaultProject(projectID, projectName string) tea.Cmd {
 	return func() tea.Msg {
-		err := config.SetConfigValue(flags.CliConfig, flags.CliConfigPath, "", "default_cloud_project", projectID)
+		err := config.SetConfigValue(flags.CliConfig, "", "", "default_cloud_project", projectID)
 		return setDefaultProjectMsg{
 			projectID:   projectID,
 			projectName: projectName,
@@ -7,6 +7,7 @@ package cdndedicated
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
@@ -19,8 +20,14 @@ var (
 	cdndedicatedTemplate string
 )
 
-func ListCdnDedicated(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/cdn/dedicated", "", cdndedicatedColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("cdndedicated", map[string]*string{
+		"cdndedicated": &cdndedicatedTemplate,
+	})
+}
+
+func ListCdnDedicated(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/cdn/dedicated", "", cdndedicatedColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetCdnDedicated(_ *cobra.Command, args []string) {
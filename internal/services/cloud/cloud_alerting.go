@@ -54,8 +54,15 @@ var (
 	cloudprojectAlertingTriggeredAlertColumnsToDisplay = []string{"alertId", "alertDate", "emails"}
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_alerting":       &cloudAlertingConfigTemplate,
+		"cloud_alerting_alert": &cloudAlertingTriggeredAlertTemplate,
+	})
+}
+
 // ListCloudAlertingConfigs lists all billing alert configurations for a project
-func ListCloudAlertingConfigs(_ *cobra.Command, _ []string) {
+func ListCloudAlertingConfigs(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -63,7 +70,7 @@ func ListCloudAlertingConfigs(_ *cobra.Command, _ []string) {
 	}
 
 	path := fmt.Sprintf("/v1/cloud/project/%s/alerting", projectID)
-	body, err := httpLib.FetchExpandedArray(path, "")
+	body, err := httpLib.FetchExpandedArray(cmd.Context(), path, "")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch alerting configurations: %s", err)
 		return
@@ -196,7 +203,7 @@ func DeleteCloudAlertingConfig(_ *cobra.Command, args []string) {
 }
 
 // ListCloudAlertingTriggeredAlerts lists all triggered alerts for a specific alert configuration
-func ListCloudAlertingTriggeredAlerts(_ *cobra.Command, args []string) {
+func ListCloudAlertingTriggeredAlerts(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -204,7 +211,7 @@ func ListCloudAlertingTriggeredAlerts(_ *cobra.Command, args []string) {
 	}
 
 	path := fmt.Sprintf("/v1/cloud/project/%s/alerting/%s/alert", projectID, url.PathEscape(args[0]))
-	body, err := httpLib.FetchExpandedArray(path, "")
+	body, err := httpLib.FetchExpandedArray(cmd.Context(), path, "")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch triggered alerts: %s", err)
 		return
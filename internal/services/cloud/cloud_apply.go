@@ -0,0 +1,1169 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/ovh/ovhcloud-cli/internal/assets"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/ovh/ovhcloud-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// Kinds of the resources managed by a cloud manifest
+const (
+	applyKindSSHKey       = "ssh key"
+	applyKindNetwork      = "private network"
+	applyKindSubnet       = "subnet"
+	applyKindGateway      = "gateway"
+	applyKindVolume       = "volume"
+	applyKindS3Container  = "s3 container"
+	applyKindKubeCluster  = "kube cluster"
+	applyKindKubeNodepool = "kube node pool"
+)
+
+// Actions of a plan
+const (
+	applyActionCreate = "create"
+	applyActionUpdate = "update"
+	applyActionDelete = "delete"
+)
+
+var (
+	// CloudManifestFile is the path of the manifest describing the resources of a cloud project
+	CloudManifestFile string
+
+	// CloudApplyPrune indicates whether the resources not described in the manifest must be deleted
+	CloudApplyPrune bool
+)
+
+// cloudManifest describes the resources of a cloud project. The resources are described
+// using the parameters of their creation, the lists not given are not managed.
+type cloudManifest struct {
+	// Region is the default region of the resources
+	Region string `json:"region,omitempty"`
+
+	SSHKeys      []map[string]any `json:"sshKeys,omitempty"`
+	Networks     []map[string]any `json:"networks,omitempty"`
+	Subnets      []map[string]any `json:"subnets,omitempty"`
+	Gateways     []map[string]any `json:"gateways,omitempty"`
+	Volumes      []map[string]any `json:"volumes,omitempty"`
+	S3Containers []map[string]any `json:"s3Containers,omitempty"`
+	KubeClusters []map[string]any `json:"kubeClusters,omitempty"`
+}
+
+// applyAction is a change of a resource of the plan computed from a manifest
+type applyAction struct {
+	Action  string               `json:"action"`
+	Kind    string               `json:"kind"`
+	Name    string               `json:"name"`
+	Region  string               `json:"region,omitempty"`
+	Parent  string               `json:"parent,omitempty"`
+	Changes []display.DiffChange `json:"changes,omitempty"`
+
+	run func(ctx context.Context) error
+}
+
+func (a *applyAction) String() string {
+	description := fmt.Sprintf("%s %q", a.Kind, a.Name)
+	if a.Parent != "" {
+		description += " of " + a.Parent
+	}
+	if a.Region != "" {
+		description += " in " + a.Region
+	}
+	return description
+}
+
+// cloudPlanner computes the actions applying a manifest to a cloud project
+type cloudPlanner struct {
+	projectID string
+	manifest  *cloudManifest
+	prune     bool
+
+	// networks are the private networks of the project, fetched again
+	// when a network created during the apply is looked up
+	networks []PrivateNetwork
+
+	// deletedNetworks are the IDs of the networks deleted by the plan,
+	// their subnets being deleted with them
+	deletedNetworks map[string]bool
+}
+
+func PlanCloudManifest(cmd *cobra.Command, _ []string) {
+	actions, ok := computeCloudPlan(cmd.Context())
+	if !ok {
+		return
+	}
+
+	if flags.OutputFormatConfig.Output != "" {
+		display.OutputObject(cloudPlanObject(actions), "", "", &flags.OutputFormatConfig)
+		return
+	}
+
+	if len(actions) == 0 {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ No changes, the cloud project matches the manifest")
+		return
+	}
+
+	renderCloudPlan(os.Stdout, actions)
+}
+
+func ApplyCloudManifest(cmd *cobra.Command, _ []string) {
+	actions, ok := computeCloudPlan(cmd.Context())
+	if !ok {
+		return
+	}
+
+	if len(actions) == 0 {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "🟠 Cloud project is already up to date, nothing to apply")
+		return
+	}
+
+	renderCloudPlan(os.Stderr, actions)
+
+	if !flags.AssumeYes {
+		// The changes are never applied without approval, given with --yes when
+		// they cannot be confirmed
+		if !utils.IsInteractiveTerminal() {
+			display.OutputError(&flags.OutputFormatConfig, "changes cannot be confirmed without an interactive terminal, use --yes to apply them")
+			return
+		}
+
+		confirmed, err := common.Confirm("Apply these changes?")
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		}
+		if !confirmed {
+			display.OutputInfo(&flags.OutputFormatConfig, nil, "🟠 Apply cancelled, no resource changed")
+			return
+		}
+	}
+
+	for i, action := range actions {
+		fmt.Fprintf(os.Stderr, "%s %s...\n", applyActionProgress(action.Action), action)
+
+		if err := action.run(cmd.Context()); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to %s %s (%d of %d changes applied): %s", action.Action, action, i, len(actions), err)
+			return
+		}
+	}
+
+	plan := cloudPlanObject(actions)
+	display.OutputInfo(&flags.OutputFormatConfig, plan, "✅ Apply complete: %d created, %d updated, %d deleted",
+		plan[applyActionCreate], plan[applyActionUpdate], plan[applyActionDelete])
+}
+
+// computeCloudPlan reads the manifest given by flag and returns the actions applying it
+// to the configured cloud project, in the order they must be run. It outputs the errors
+// and returns false on failure.
+func computeCloudPlan(ctx context.Context) ([]*applyAction, bool) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return nil, false
+	}
+
+	manifest, err := readCloudManifest(CloudManifestFile)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "invalid manifest %s: %s", CloudManifestFile, err)
+		return nil, false
+	}
+
+	planner := &cloudPlanner{
+		projectID:       projectID,
+		manifest:        manifest,
+		prune:           CloudApplyPrune,
+		deletedNetworks: make(map[string]bool),
+	}
+
+	actions, err := planner.plan(ctx)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to compute plan: %s", err)
+		return nil, false
+	}
+
+	return actions, true
+}
+
+// readCloudManifest reads and checks the manifest at the given path ("-" for the standard input)
+func readCloudManifest(path string) (*cloudManifest, error) {
+	var (
+		content []byte
+		err     error
+	)
+	if path == "-" {
+		content, err = io.ReadAll(os.Stdin)
+	} else {
+		content, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	jsonContent, err := yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest cloudManifest
+	decoder := json.NewDecoder(bytes.NewReader(jsonContent))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&manifest); err != nil {
+		return nil, err
+	}
+
+	return &manifest, manifest.check()
+}
+
+// check validates the resources of the manifest, setting the default region
+// of the resources not having one
+func (m *cloudManifest) check() error {
+	kinds := []struct {
+		kind      string
+		entries   []map[string]any
+		keyFields []string
+		region    bool
+		mandatory []string
+	}{
+		{applyKindSSHKey, m.SSHKeys, []string{"name"}, false, []string{"publicKey"}},
+		{applyKindNetwork, m.Networks, []string{"name"}, true, []string{"subnet"}},
+		{applyKindSubnet, m.Subnets, []string{"privateNetwork", "network"}, true, []string{"start", "end"}},
+		{applyKindGateway, m.Gateways, []string{"name"}, true, []string{"model"}},
+		{applyKindVolume, m.Volumes, []string{"name"}, true, []string{"size", "type"}},
+		{applyKindS3Container, m.S3Containers, []string{"name"}, true, nil},
+		{applyKindKubeCluster, m.KubeClusters, []string{"name"}, true, nil},
+	}
+
+	for _, kind := range kinds {
+		seen := make(map[string]bool)
+
+		for i, entry := range kind.entries {
+			if entry == nil {
+				return fmt.Errorf("%s #%d is empty", kind.kind, i+1)
+			}
+
+			var keys []string
+			for _, field := range kind.keyFields {
+				value, _ := entry[field].(string)
+				if value == "" {
+					return fmt.Errorf("%s #%d has no %q field", kind.kind, i+1, field)
+				}
+				keys = append(keys, value)
+			}
+			key := strings.Join(keys, " ")
+
+			if kind.region {
+				if _, ok := entry["region"]; !ok && m.Region != "" {
+					entry["region"] = m.Region
+				}
+				region, _ := entry["region"].(string)
+				if region == "" {
+					return fmt.Errorf("%s %q has no region and no default region is defined", kind.kind, key)
+				}
+				key += " " + region
+			}
+
+			if seen[key] {
+				return fmt.Errorf("%s %q is defined several times", kind.kind, key)
+			}
+			seen[key] = true
+
+			for _, field := range kind.mandatory {
+				if _, ok := entry[field]; !ok {
+					return fmt.Errorf("%s %q has no %q field", kind.kind, key, field)
+				}
+			}
+		}
+	}
+
+	// Check the node pools of the clusters
+	for _, cluster := range m.KubeClusters {
+		nodepools, err := kubeManifestNodepools(cluster)
+		if err != nil {
+			return fmt.Errorf("%s %q: %w", applyKindKubeCluster, cluster["name"], err)
+		}
+
+		seen := make(map[string]bool)
+		for i, nodepool := range nodepools {
+			name, _ := nodepool["name"].(string)
+			if name == "" {
+				return fmt.Errorf("%s #%d of cluster %q has no \"name\" field", applyKindKubeNodepool, i+1, cluster["name"])
+			}
+			if seen[name] {
+				return fmt.Errorf("%s %q of cluster %q is defined several times", applyKindKubeNodepool, name, cluster["name"])
+			}
+			seen[name] = true
+
+			if _, ok := nodepool["flavorName"]; !ok {
+				return fmt.Errorf("%s %q of cluster %q has no \"flavorName\" field", applyKindKubeNodepool, name, cluster["name"])
+			}
+		}
+	}
+
+	return nil
+}
+
+// kubeManifestNodepools returns the node pools of the given cluster of a manifest
+func kubeManifestNodepools(cluster map[string]any) ([]map[string]any, error) {
+	value, ok := cluster["nodepools"]
+	if !ok || value == nil {
+		return nil, nil
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		return nil, errors.New(`"nodepools" must be a list`)
+	}
+
+	nodepools := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		nodepool, ok := item.(map[string]any)
+		if !ok {
+			return nil, errors.New(`"nodepools" must be a list of objects`)
+		}
+		nodepools = append(nodepools, nodepool)
+	}
+
+	return nodepools, nil
+}
+
+// plan returns the actions applying the manifest. The resources are deleted first,
+// in reverse dependency order, then created or updated in dependency order.
+func (p *cloudPlanner) plan(ctx context.Context) ([]*applyAction, error) {
+	steps := []func(context.Context) ([]*applyAction, []*applyAction, error){
+		p.planSSHKeys,
+		p.planNetworks,
+		p.planSubnets,
+		p.planGateways,
+		p.planVolumes,
+		p.planS3Containers,
+		p.planKubeClusters,
+	}
+
+	if p.manifest.Networks != nil || p.manifest.Subnets != nil || p.manifest.Gateways != nil || p.manifest.KubeClusters != nil {
+		if err := p.fetchNetworks(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	var changes, deletes []*applyAction
+	for _, step := range steps {
+		stepChanges, stepDeletes, err := step(ctx)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, stepChanges...)
+		deletes = append(stepDeletes, deletes...)
+	}
+
+	return append(deletes, changes...), nil
+}
+
+func (p *cloudPlanner) planSSHKeys(ctx context.Context) ([]*applyAction, []*applyAction, error) {
+	if p.manifest.SSHKeys == nil {
+		return nil, nil, nil
+	}
+
+	var liveKeys []map[string]any
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/sshkey", p.projectID)
+	if err := httpLib.Client.GetWithContext(ctx, endpoint, &liveKeys); err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch SSH keys: %w", err)
+	}
+
+	var (
+		changes, deletes []*applyAction
+		declared         = make(map[string]bool)
+	)
+
+	for _, entry := range p.manifest.SSHKeys {
+		name := entry["name"].(string)
+		declared[name] = true
+
+		// SSH keys cannot be updated
+		if slices.ContainsFunc(liveKeys, func(key map[string]any) bool { return key["name"] == name }) {
+			continue
+		}
+
+		body := maps.Clone(entry)
+		changes = append(changes, newCreateAction(applyKindSSHKey, name, "", "", entry, func(ctx context.Context) error {
+			_, err := common.PostResource(ctx, "/cloud/project/{serviceName}/sshkey", endpoint, body, assets.CloudOpenapiSchema)
+			return err
+		}))
+	}
+
+	if p.prune {
+		for _, key := range liveKeys {
+			if name, _ := key["name"].(string); !declared[name] {
+				deletes = append(deletes, newDeleteAction(applyKindSSHKey, name, "", "",
+					fmt.Sprintf("%s/%s", endpoint, url.PathEscape(fmt.Sprint(key["id"])))))
+			}
+		}
+	}
+
+	return changes, deletes, nil
+}
+
+func (p *cloudPlanner) planNetworks(_ context.Context) ([]*applyAction, []*applyAction, error) {
+	if p.manifest.Networks == nil {
+		return nil, nil, nil
+	}
+
+	var (
+		changes, deletes []*applyAction
+		declared         = make(map[string]bool)
+	)
+
+	for _, entry := range p.manifest.Networks {
+		name, region := entry["name"].(string), entry["region"].(string)
+		declared[name] = true
+
+		// Private networks cannot be updated
+		if network, _ := p.findNetwork(name, region); network != nil {
+			continue
+		}
+
+		body := manifestBody(entry, "region")
+		changes = append(changes, newCreateAction(applyKindNetwork, name, region, "", entry, func(ctx context.Context) error {
+			task, err := common.PostResource(ctx,
+				"/cloud/project/{serviceName}/region/{regionName}/network",
+				fmt.Sprintf("/v1/cloud/project/%s/region/%s/network", p.projectID, url.PathEscape(region)),
+				body, assets.CloudOpenapiSchema)
+			if err != nil {
+				return err
+			}
+
+			return p.waitForOperation(ctx, task, "network#create", 10*time.Minute)
+		}))
+	}
+
+	if p.prune {
+		for _, network := range p.networks {
+			if declared[network.Name] {
+				continue
+			}
+
+			p.deletedNetworks[network.ID] = true
+			deletes = append(deletes, newDeleteAction(applyKindNetwork, network.Name, "", "",
+				fmt.Sprintf("/v1/cloud/project/%s/network/private/%s", p.projectID, url.PathEscape(network.ID))))
+		}
+	}
+
+	return changes, deletes, nil
+}
+
+func (p *cloudPlanner) planSubnets(ctx context.Context) ([]*applyAction, []*applyAction, error) {
+	if p.manifest.Subnets == nil {
+		return nil, nil, nil
+	}
+
+	var (
+		changes, deletes []*applyAction
+		liveSubnets      = make(map[string][]map[string]any)
+		declared         = make(map[string]map[string]bool)
+	)
+
+	// The subnets created with the networks are also declared
+	for _, entry := range p.manifest.Networks {
+		name := entry["name"].(string)
+		if subnet, ok := entry["subnet"].(map[string]any); ok {
+			if declared[name] == nil {
+				declared[name] = make(map[string]bool)
+			}
+			declared[name][fmt.Sprint(subnet["cidr"])] = true
+		}
+	}
+
+	for _, entry := range p.manifest.Subnets {
+		var (
+			networkName = entry["privateNetwork"].(string)
+			cidr        = entry["network"].(string)
+			region      = entry["region"].(string)
+			parent      = fmt.Sprintf("%s %q", applyKindNetwork, networkName)
+		)
+
+		if declared[networkName] == nil {
+			declared[networkName] = make(map[string]bool)
+		}
+		declared[networkName][cidr] = true
+
+		// Subnets cannot be updated
+		if network, _ := p.findNetwork(networkName, region); network != nil {
+			subnets, err := p.fetchNetworkSubnets(ctx, network.ID, liveSubnets)
+			if err != nil {
+				return nil, nil, err
+			}
+			if slices.ContainsFunc(subnets, func(subnet map[string]any) bool { return subnet["cidr"] == cidr }) {
+				continue
+			}
+		}
+
+		body := manifestBody(entry, "privateNetwork")
+		changes = append(changes, newCreateAction(applyKindSubnet, cidr, region, parent, entry, func(ctx context.Context) error {
+			networkID, _, err := p.resolveNetwork(ctx, networkName, region)
+			if err != nil {
+				return err
+			}
+
+			_, err = common.PostResource(ctx,
+				"/cloud/project/{serviceName}/network/private/{networkId}/subnet",
+				fmt.Sprintf("/v1/cloud/project/%s/network/private/%s/subnet", p.projectID, url.PathEscape(networkID)),
+				body, assets.CloudOpenapiSchema)
+			return err
+		}))
+	}
+
+	if p.prune {
+		// Only the subnets of the networks described in the manifest are deleted
+		for _, network := range p.networks {
+			if declared[network.Name] == nil || p.deletedNetworks[network.ID] {
+				continue
+			}
+
+			subnets, err := p.fetchNetworkSubnets(ctx, network.ID, liveSubnets)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for _, subnet := range subnets {
+				cidr := fmt.Sprint(subnet["cidr"])
+				if declared[network.Name][cidr] {
+					continue
+				}
+
+				deletes = append(deletes, newDeleteAction(applyKindSubnet, cidr, "", fmt.Sprintf("%s %q", applyKindNetwork, network.Name),
+					fmt.Sprintf("/v1/cloud/project/%s/network/private/%s/subnet/%s",
+						p.projectID, url.PathEscape(network.ID), url.PathEscape(fmt.Sprint(subnet["id"])))))
+			}
+		}
+	}
+
+	return changes, deletes, nil
+}
+
+func (p *cloudPlanner) planGateways(ctx context.Context) ([]*applyAction, []*applyAction, error) {
+	if p.manifest.Gateways == nil {
+		return nil, nil, nil
+	}
+
+	regions, err := getCloudRegionsWithFeatureAvailable(ctx, p.projectID, "network")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch regions with network feature available: %w", err)
+	}
+
+	gateways, err := httpLib.FetchObjectsParallel[[]map[string]any](ctx, fmt.Sprintf("/v1/cloud/project/%s/region", p.projectID)+"/%s/gateway", regions, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch gateways: %w", err)
+	}
+
+	var liveGateways []map[string]any
+	for _, regionGateways := range gateways {
+		liveGateways = append(liveGateways, regionGateways...)
+	}
+
+	var (
+		changes, deletes []*applyAction
+		declared         = make(map[string]bool)
+	)
+
+	for _, entry := range p.manifest.Gateways {
+		name, region := entry["name"].(string), entry["region"].(string)
+		declared[name+" "+region] = true
+
+		body := manifestBody(entry, "region", "privateNetwork", "subnet")
+
+		if gateway := findLiveResource(liveGateways, name, region); gateway != nil {
+			action, err := newUpdateAction(ctx, applyKindGateway, name, region, "",
+				"/cloud/project/{serviceName}/region/{regionName}/gateway/{id}",
+				fmt.Sprintf("/v1/cloud/project/%s/region/%s/gateway/%s", p.projectID, url.PathEscape(region), url.PathEscape(fmt.Sprint(gateway["id"]))),
+				body)
+			if err != nil {
+				return nil, nil, err
+			}
+			if action != nil {
+				changes = append(changes, action)
+			}
+			continue
+		}
+
+		networkName, _ := entry["privateNetwork"].(string)
+		subnetCIDR, _ := entry["subnet"].(string)
+		if networkName != "" && subnetCIDR == "" {
+			return nil, nil, fmt.Errorf("%s %q has a private network but no subnet", applyKindGateway, name)
+		}
+
+		changes = append(changes, newCreateAction(applyKindGateway, name, region, "", entry, func(ctx context.Context) error {
+			var (
+				path     = "/cloud/project/{serviceName}/region/{regionName}/gateway"
+				endpoint = fmt.Sprintf("/v1/cloud/project/%s/region/%s/gateway", p.projectID, url.PathEscape(region))
+			)
+
+			// Attach the gateway to an existing subnet
+			if networkName != "" {
+				_, openstackID, err := p.resolveNetwork(ctx, networkName, region)
+				if err != nil {
+					return err
+				}
+				subnetID, err := p.resolveSubnet(ctx, region, openstackID, subnetCIDR)
+				if err != nil {
+					return err
+				}
+
+				path = "/cloud/project/{serviceName}/region/{regionName}/network/{networkId}/subnet/{subnetId}/gateway"
+				endpoint = fmt.Sprintf("/v1/cloud/project/%s/region/%s/network/%s/subnet/%s/gateway",
+					p.projectID, url.PathEscape(region), url.PathEscape(openstackID), url.PathEscape(subnetID))
+			}
+
+			task, err := common.PostResource(ctx, path, endpoint, body, assets.CloudOpenapiSchema)
+			if err != nil {
+				return err
+			}
+
+			return p.waitForOperation(ctx, task, "gateway#create", 30*time.Minute)
+		}))
+	}
+
+	if p.prune {
+		for _, gateway := range liveGateways {
+			name, region := fmt.Sprint(gateway["name"]), fmt.Sprint(gateway["region"])
+			if declared[name+" "+region] {
+				continue
+			}
+
+			deletes = append(deletes, newDeleteAction(applyKindGateway, name, region, "",
+				fmt.Sprintf("/v1/cloud/project/%s/region/%s/gateway/%s", p.projectID, url.PathEscape(region), url.PathEscape(fmt.Sprint(gateway["id"])))))
+		}
+	}
+
+	return changes, deletes, nil
+}
+
+func (p *cloudPlanner) planVolumes(ctx context.Context) ([]*applyAction, []*applyAction, error) {
+	if p.manifest.Volumes == nil {
+		return nil, nil, nil
+	}
+
+	var liveVolumes []map[string]any
+	if err := httpLib.Client.GetWithContext(ctx, fmt.Sprintf("/v1/cloud/project/%s/volume", p.projectID), &liveVolumes); err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch volumes: %w", err)
+	}
+
+	var (
+		changes, deletes []*applyAction
+		declared         = make(map[string]bool)
+	)
+
+	for _, entry := range p.manifest.Volumes {
+		name, region := entry["name"].(string), entry["region"].(string)
+		declared[name+" "+region] = true
+
+		body := manifestBody(entry, "region")
+
+		if volume := findLiveResource(liveVolumes, name, region); volume != nil {
+			action, err := newUpdateAction(ctx, applyKindVolume, name, region, "",
+				"/cloud/project/{serviceName}/volume/{volumeId}",
+				fmt.Sprintf("/v1/cloud/project/%s/volume/%s", p.projectID, url.PathEscape(fmt.Sprint(volume["id"]))),
+				body)
+			if err != nil {
+				return nil, nil, err
+			}
+			if action != nil {
+				changes = append(changes, action)
+			}
+			continue
+		}
+
+		changes = append(changes, newCreateAction(applyKindVolume, name, region, "", entry, func(ctx context.Context) error {
+			task, err := common.PostResource(ctx,
+				"/cloud/project/{serviceName}/region/{regionName}/volume",
+				fmt.Sprintf("/v1/cloud/project/%s/region/%s/volume", p.projectID, url.PathEscape(region)),
+				body, assets.CloudOpenapiSchema)
+			if err != nil {
+				return err
+			}
+
+			return p.waitForOperation(ctx, task, "ablockstorage.CreateVolume", 10*time.Minute)
+		}))
+	}
+
+	if p.prune {
+		for _, volume := range liveVolumes {
+			name, region := fmt.Sprint(volume["name"]), fmt.Sprint(volume["region"])
+			if declared[name+" "+region] {
+				continue
+			}
+
+			deletes = append(deletes, newDeleteAction(applyKindVolume, name, region, "",
+				fmt.Sprintf("/v1/cloud/project/%s/volume/%s", p.projectID, url.PathEscape(fmt.Sprint(volume["id"])))))
+		}
+	}
+
+	return changes, deletes, nil
+}
+
+func (p *cloudPlanner) planS3Containers(ctx context.Context) ([]*applyAction, []*applyAction, error) {
+	if p.manifest.S3Containers == nil {
+		return nil, nil, nil
+	}
+
+	regions, err := getCloudRegionsWithFeatureAvailable(ctx, p.projectID, "storage-s3-high-perf", "storage-s3-standard")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch regions with storage feature available: %w", err)
+	}
+
+	containers, err := httpLib.FetchObjectsParallel[[]map[string]any](ctx, fmt.Sprintf("/v1/cloud/project/%s/region", p.projectID)+"/%s/storage", regions, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch storage containers: %w", err)
+	}
+
+	var liveContainers []map[string]any
+	for _, regionContainers := range containers {
+		liveContainers = append(liveContainers, regionContainers...)
+	}
+
+	var (
+		changes, deletes []*applyAction
+		declared         = make(map[string]bool)
+	)
+
+	for _, entry := range p.manifest.S3Containers {
+		name, region := entry["name"].(string), entry["region"].(string)
+		declared[name+" "+region] = true
+
+		var (
+			body     = manifestBody(entry, "region")
+			endpoint = fmt.Sprintf("/v1/cloud/project/%s/region/%s/storage", p.projectID, url.PathEscape(region))
+		)
+
+		if findLiveResource(liveContainers, name, region) != nil {
+			action, err := newUpdateAction(ctx, applyKindS3Container, name, region, "",
+				"/cloud/project/{serviceName}/region/{regionName}/storage/{name}",
+				fmt.Sprintf("%s/%s", endpoint, url.PathEscape(name)),
+				body)
+			if err != nil {
+				return nil, nil, err
+			}
+			if action != nil {
+				changes = append(changes, action)
+			}
+			continue
+		}
+
+		changes = append(changes, newCreateAction(applyKindS3Container, name, region, "", entry, func(ctx context.Context) error {
+			_, err := common.PostResource(ctx, "/cloud/project/{serviceName}/region/{regionName}/storage", endpoint, body, assets.CloudOpenapiSchema)
+			return err
+		}))
+	}
+
+	if p.prune {
+		for _, container := range liveContainers {
+			name, region := fmt.Sprint(container["name"]), fmt.Sprint(container["region"])
+			if declared[name+" "+region] {
+				continue
+			}
+
+			deletes = append(deletes, newDeleteAction(applyKindS3Container, name, region, "",
+				fmt.Sprintf("/v1/cloud/project/%s/region/%s/storage/%s", p.projectID, url.PathEscape(region), url.PathEscape(name))))
+		}
+	}
+
+	return changes, deletes, nil
+}
+
+func (p *cloudPlanner) planKubeClusters(ctx context.Context) ([]*applyAction, []*applyAction, error) {
+	if p.manifest.KubeClusters == nil {
+		return nil, nil, nil
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/kube", p.projectID)
+	liveClusters, err := httpLib.FetchExpandedArray(ctx, endpoint, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch Kubernetes clusters: %w", err)
+	}
+
+	var (
+		changes, deletes []*applyAction
+		declared         = make(map[string]bool)
+	)
+
+	for _, entry := range p.manifest.KubeClusters {
+		name, region := entry["name"].(string), entry["region"].(string)
+		declared[name] = true
+
+		nodepools, err := kubeManifestNodepools(entry)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		cluster := findLiveResource(liveClusters, name, "")
+		if cluster == nil {
+			changes = append(changes, p.newKubeClusterCreation(entry))
+			continue
+		}
+
+		clusterURL := fmt.Sprintf("%s/%s", endpoint, url.PathEscape(fmt.Sprint(cluster["id"])))
+		action, err := newUpdateAction(ctx, applyKindKubeCluster, name, region, "",
+			"/cloud/project/{serviceName}/kube/{kubeId}",
+			clusterURL,
+			manifestBody(entry, "region", "privateNetwork", "nodesSubnet", "loadBalancersSubnet", "nodepools"))
+		if err != nil {
+			return nil, nil, err
+		}
+		if action != nil {
+			changes = append(changes, action)
+		}
+
+		// Node pools of the existing cluster, not managed if not given
+		if nodepools == nil {
+			continue
+		}
+
+		var liveNodepools []map[string]any
+		if err := httpLib.Client.GetWithContext(ctx, clusterURL+"/nodepool", &liveNodepools); err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch node pools of cluster %s: %w", name, err)
+		}
+
+		var (
+			parent            = fmt.Sprintf("%s %q", applyKindKubeCluster, name)
+			declaredNodepools = make(map[string]bool)
+		)
+
+		for _, nodepool := range nodepools {
+			nodepoolName := nodepool["name"].(string)
+			declaredNodepools[nodepoolName] = true
+			body := maps.Clone(nodepool)
+
+			if liveNodepool := findLiveResource(liveNodepools, nodepoolName, ""); liveNodepool != nil {
+				action, err := newUpdateAction(ctx, applyKindKubeNodepool, nodepoolName, "", parent,
+					"/cloud/project/{serviceName}/kube/{kubeId}/nodepool/{nodepoolId}",
+					fmt.Sprintf("%s/nodepool/%s", clusterURL, url.PathEscape(fmt.Sprint(liveNodepool["id"]))),
+					body)
+				if err != nil {
+					return nil, nil, err
+				}
+				if action != nil {
+					changes = append(changes, action)
+				}
+				continue
+			}
+
+			changes = append(changes, newCreateAction(applyKindKubeNodepool, nodepoolName, "", parent, nodepool, func(ctx context.Context) error {
+				_, err := common.PostResource(ctx, "/cloud/project/{serviceName}/kube/{kubeId}/nodepool", clusterURL+"/nodepool", body, assets.CloudOpenapiSchema)
+				return err
+			}))
+		}
+
+		if p.prune {
+			for _, liveNodepool := range liveNodepools {
+				nodepoolName := fmt.Sprint(liveNodepool["name"])
+				if declaredNodepools[nodepoolName] {
+					continue
+				}
+
+				deletes = append(deletes, newDeleteAction(applyKindKubeNodepool, nodepoolName, "", parent,
+					fmt.Sprintf("%s/nodepool/%s", clusterURL, url.PathEscape(fmt.Sprint(liveNodepool["id"])))))
+			}
+		}
+	}
+
+	if p.prune {
+		for _, cluster := range liveClusters {
+			name := fmt.Sprint(cluster["name"])
+			if declared[name] {
+				continue
+			}
+
+			deletes = append(deletes, newDeleteAction(applyKindKubeCluster, name, fmt.Sprint(cluster["region"]), "",
+				fmt.Sprintf("%s/%s", endpoint, url.PathEscape(fmt.Sprint(cluster["id"])))))
+		}
+	}
+
+	return changes, deletes, nil
+}
+
+// newKubeClusterCreation returns the action creating the given cluster of the manifest,
+// along with its node pools
+func (p *cloudPlanner) newKubeClusterCreation(entry map[string]any) *applyAction {
+	var (
+		name, region           = entry["name"].(string), entry["region"].(string)
+		networkName, _         = entry["privateNetwork"].(string)
+		nodesSubnet, _         = entry["nodesSubnet"].(string)
+		loadBalancersSubnet, _ = entry["loadBalancersSubnet"].(string)
+		body                   = manifestBody(entry, "privateNetwork", "nodesSubnet", "loadBalancersSubnet")
+	)
+
+	return newCreateAction(applyKindKubeCluster, name, region, "", entry, func(ctx context.Context) error {
+		if networkName != "" {
+			_, openstackID, err := p.resolveNetwork(ctx, networkName, region)
+			if err != nil {
+				return err
+			}
+			body["privateNetworkId"] = openstackID
+
+			for field, cidr := range map[string]string{"nodesSubnetId": nodesSubnet, "loadBalancersSubnetId": loadBalancersSubnet} {
+				if cidr == "" {
+					continue
+				}
+				if body[field], err = p.resolveSubnet(ctx, region, openstackID, cidr); err != nil {
+					return err
+				}
+			}
+		}
+
+		cluster, err := common.PostResource(ctx, "/cloud/project/{serviceName}/kube", fmt.Sprintf("/v1/cloud/project/%s/kube", p.projectID), body, assets.CloudOpenapiSchema)
+		if err != nil {
+			return err
+		}
+
+		if !flags.WaitForTask || flags.DryRun {
+			return nil
+		}
+
+		_, err = waitForKubeReady(ctx, p.projectID, fmt.Sprint(cluster["id"]), 0)
+		return err
+	})
+}
+
+func (p *cloudPlanner) fetchNetworks(ctx context.Context) error {
+	var networks []PrivateNetwork
+	if err := httpLib.Client.GetWithContext(ctx, fmt.Sprintf("/v1/cloud/project/%s/network/private", p.projectID), &networks); err != nil {
+		return fmt.Errorf("failed to fetch private networks: %w", err)
+	}
+	p.networks = networks
+
+	return nil
+}
+
+func (p *cloudPlanner) fetchNetworkSubnets(ctx context.Context, networkID string, cache map[string][]map[string]any) ([]map[string]any, error) {
+	if subnets, ok := cache[networkID]; ok {
+		return subnets, nil
+	}
+
+	var subnets []map[string]any
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/network/private/%s/subnet", p.projectID, url.PathEscape(networkID))
+	if err := httpLib.Client.GetWithContext(ctx, endpoint, &subnets); err != nil {
+		return nil, fmt.Errorf("failed to fetch subnets of private network %s: %w", networkID, err)
+	}
+	cache[networkID] = subnets
+
+	return subnets, nil
+}
+
+// findNetwork returns the private network having the given name in the given
+// region, and its OpenStack ID in this region
+func (p *cloudPlanner) findNetwork(name, region string) (*PrivateNetwork, string) {
+	for i, network := range p.networks {
+		if network.Name != name {
+			continue
+		}
+		for _, details := range network.Regions {
+			if details.Region == region {
+				return &p.networks[i], details.OpenstackID
+			}
+		}
+	}
+
+	return nil, ""
+}
+
+// resolveNetwork returns the ID and the OpenStack ID of the private network having
+// the given name in the given region, fetching the networks again if it has been
+// created since the plan was computed
+func (p *cloudPlanner) resolveNetwork(ctx context.Context, name, region string) (string, string, error) {
+	network, openstackID := p.findNetwork(name, region)
+	if network == nil {
+		if err := p.fetchNetworks(ctx); err != nil {
+			return "", "", err
+		}
+		network, openstackID = p.findNetwork(name, region)
+	}
+
+	switch {
+	case network != nil:
+		return network.ID, openstackID, nil
+	case flags.DryRun:
+		// The network is not created in dry-run mode
+		return "<" + name + ">", "<" + name + ">", nil
+	default:
+		return "", "", fmt.Errorf("private network %q not found in region %s", name, region)
+	}
+}
+
+// resolveSubnet returns the OpenStack ID of the subnet having the given CIDR
+// in the given network
+func (p *cloudPlanner) resolveSubnet(ctx context.Context, region, openstackNetworkID, cidr string) (string, error) {
+	var subnets []map[string]any
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/network/%s/subnet",
+		p.projectID, url.PathEscape(region), url.PathEscape(openstackNetworkID))
+	if err := httpLib.Client.GetWithContext(ctx, endpoint, &subnets); err != nil && !flags.DryRun {
+		return "", fmt.Errorf("failed to fetch subnets of network %s: %w", openstackNetworkID, err)
+	}
+
+	for _, subnet := range subnets {
+		if subnet["cidr"] == cidr {
+			return fmt.Sprint(subnet["id"]), nil
+		}
+	}
+
+	if flags.DryRun {
+		return "<" + cidr + ">", nil
+	}
+
+	return "", fmt.Errorf("subnet %s not found in network %s", cidr, openstackNetworkID)
+}
+
+// waitForOperation waits for the cloud operation returned by a creation request
+func (p *cloudPlanner) waitForOperation(ctx context.Context, task map[string]any, action string, timeout time.Duration) error {
+	// No operation is started in dry-run mode
+	if flags.DryRun {
+		return nil
+	}
+
+	operationID, _ := task["id"].(string)
+	if operationID == "" {
+		return errors.New("no operation returned by the API")
+	}
+
+	_, err := waitForCloudOperation(ctx, p.projectID, operationID, action, timeout)
+	return err
+}
+
+// findLiveResource returns the resource of the given list having the given name,
+// and the given region if not empty
+func findLiveResource(resources []map[string]any, name, region string) map[string]any {
+	for _, resource := range resources {
+		if resource["name"] == name && (region == "" || resource["region"] == region) {
+			return resource
+		}
+	}
+
+	return nil
+}
+
+// manifestBody returns a copy of the given resource of a manifest, without
+// the given fields not being parameters of the API
+func manifestBody(entry map[string]any, fields ...string) map[string]any {
+	body := maps.Clone(entry)
+	for _, field := range fields {
+		delete(body, field)
+	}
+
+	return body
+}
+
+func newCreateAction(kind, name, region, parent string, entry map[string]any, run func(context.Context) error) *applyAction {
+	return &applyAction{
+		Action:  applyActionCreate,
+		Kind:    kind,
+		Name:    name,
+		Region:  region,
+		Parent:  parent,
+		Changes: display.ComputeDiff(map[string]any{}, entry),
+		run:     run,
+	}
+}
+
+// newUpdateAction returns the action updating the resource at the given URL with
+// the given parameters, or nil if the resource is already up to date
+func newUpdateAction(ctx context.Context, kind, name, region, parent, path, url string, parameters map[string]any) (*applyAction, error) {
+	current, body, err := common.PrepareEdition(ctx, path, url, parameters, assets.CloudOpenapiSchema)
+	if err != nil {
+		return nil, fmt.Errorf("%s %q: %w", kind, name, err)
+	}
+
+	changes := display.ComputeDiff(current, body)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	return &applyAction{
+		Action:  applyActionUpdate,
+		Kind:    kind,
+		Name:    name,
+		Region:  region,
+		Parent:  parent,
+		Changes: changes,
+		run: func(ctx context.Context) error {
+			return common.PutResource(ctx, path, url, body, changes, assets.CloudOpenapiSchema)
+		},
+	}, nil
+}
+
+func newDeleteAction(kind, name, region, parent, url string) *applyAction {
+	return &applyAction{
+		Action: applyActionDelete,
+		Kind:   kind,
+		Name:   name,
+		Region: region,
+		Parent: parent,
+		run: func(ctx context.Context) error {
+			return httpLib.Client.DeleteWithContext(ctx, url, nil)
+		},
+	}
+}
+
+// renderCloudPlan writes the actions of a plan and their changes to the given writer
+func renderCloudPlan(w io.Writer, actions []*applyAction) {
+	for _, action := range actions {
+		fmt.Fprintf(w, "# %s will be %sd\n", action, action.Action)
+		display.RenderDiff(w, action.Changes)
+		fmt.Fprintln(w)
+	}
+
+	plan := cloudPlanObject(actions)
+	fmt.Fprintf(w, "Plan: %d to create, %d to update, %d to delete\n",
+		plan[applyActionCreate], plan[applyActionUpdate], plan[applyActionDelete])
+}
+
+// cloudPlanObject returns the given actions and their count by type
+func cloudPlanObject(actions []*applyAction) map[string]any {
+	plan := map[string]any{
+		applyActionCreate: 0,
+		applyActionUpdate: 0,
+		applyActionDelete: 0,
+	}
+
+	items := []any{}
+	for _, action := range actions {
+		plan[action.Action] = plan[action.Action].(int) + 1
+
+		// Convert actions to generic values for the output filters
+		var item map[string]any
+		content, _ := json.Marshal(action)
+		_ = json.Unmarshal(content, &item)
+		items = append(items, item)
+	}
+	plan["actions"] = items
+
+	return plan
+}
+
+func applyActionProgress(action string) string {
+	switch action {
+	case applyActionCreate:
+		return "Creating"
+	case applyActionUpdate:
+		return "Updating"
+	default:
+		return "Deleting"
+	}
+}
@@ -111,6 +111,13 @@ var (
 	}
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_container_registry":      &cloudContainerRegistryTemplate,
+		"cloud_container_registry_user": &cloudContainerRegistryUserTemplate,
+	})
+}
+
 type (
 	ContainerRegistryIPRestriction struct {
 		CreatedAt   string `json:"createdAt,omitempty"`
@@ -125,7 +132,7 @@ type (
 	}
 )
 
-func ListContainerRegistries(_ *cobra.Command, _ []string) {
+func ListContainerRegistries(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -134,14 +141,14 @@ func ListContainerRegistries(_ *cobra.Command, _ []string) {
 
 	// Fetch registries
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/containerRegistry", projectID)
-	body, err := httpLib.FetchArray(endpoint, "")
+	body, err := httpLib.FetchArray(cmd.Context(), endpoint, "")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch results: %s", err)
 		return
 	}
 
 	// Fetch cloud project regions
-	regions, err := fetchProjectRegions(projectID)
+	regions, err := fetchProjectRegions(cmd.Context(), projectID)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -322,14 +329,14 @@ func DisableContainerRegistryIAM(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Container registry IAM disabled successfully")
 }
 
-func ListContainerRegistryUsers(_ *cobra.Command, args []string) {
+func ListContainerRegistryUsers(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	common.ManageListRequestNoExpand(fmt.Sprintf("/v1/cloud/project/%s/containerRegistry/%s/users", projectID, url.PathEscape(args[0])), cloudprojectContainerRegistryUsersColumnsToDisplay, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), fmt.Sprintf("/v1/cloud/project/%s/containerRegistry/%s/users", projectID, url.PathEscape(args[0])), cloudprojectContainerRegistryUsersColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetContainerRegistryUser(_ *cobra.Command, args []string) {
@@ -5,16 +5,19 @@
 package cloud
 
 import (
+	"cmp"
 	_ "embed"
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/ovh/ovhcloud-cli/internal/assets"
 	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/ovh/ovhcloud-cli/internal/wait"
 	"github.com/spf13/cobra"
 )
 
@@ -67,6 +70,12 @@ var (
 	}
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_database": &cloudDatabaseTemplate,
+	})
+}
+
 type (
 	databaseIPRestriction struct {
 		Description string `json:"description,omitempty"`
@@ -80,14 +89,14 @@ type (
 	}
 )
 
-func ListCloudDatabases(_ *cobra.Command, _ []string) {
+func ListCloudDatabases(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	common.ManageListRequest(fmt.Sprintf("/v1/cloud/project/%s/database/service", projectID), "", cloudprojectDatabaseColumnsToDisplay, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), fmt.Sprintf("/v1/cloud/project/%s/database/service", projectID), "", cloudprojectDatabaseColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetCloudDatabase(_ *cobra.Command, args []string) {
@@ -139,7 +148,26 @@ func CreateDatabase(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, database, "✅ Database created successfully (id: %s)", database["id"])
+	if !flags.WaitForTask {
+		display.OutputInfo(&flags.OutputFormatConfig, database, "✅ Database created successfully (id: %s)", database["id"])
+		return
+	}
+
+	result, err := wait.ForResourceStatus(
+		cmd.Context(),
+		fmt.Sprintf("database %s to be ready", database["id"]),
+		fmt.Sprintf("/v1/cloud/project/%s/database/service/%s", projectID, url.PathEscape(fmt.Sprint(database["id"]))),
+		"status",
+		[]string{"READY"},
+		[]string{"ERROR"},
+		wait.Options{Timeout: time.Hour, PollInterval: 10 * time.Second},
+	)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for database to be ready: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Database created successfully and ready (id: %s)", database["id"])
 }
 
 func DeleteDatabase(cmd *cobra.Command, args []string) {
@@ -166,6 +194,33 @@ func DeleteDatabase(cmd *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Database deleted successfully")
 }
 
+// CloudDatabaseWaitFailureCondition is the default condition stopping the wait of a database service
+const CloudDatabaseWaitFailureCondition = `status=="ERROR"`
+
+func WaitCloudDatabase(cmd *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	result, err := wait.ForCondition(
+		cmd.Context(),
+		fmt.Sprintf("database service %s", args[0]),
+		fmt.Sprintf("/v1/cloud/project/%s/database/service/%s", projectID, url.PathEscape(args[0])),
+		"status",
+		flags.WaitCondition,
+		cmp.Or(flags.WaitFailureCondition, CloudDatabaseWaitFailureCondition),
+		wait.Options{Timeout: time.Hour, PollInterval: 10 * time.Second},
+	)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for database service: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Database service %s matches condition %s", args[0], flags.WaitCondition)
+}
+
 func EditDatabase(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
@@ -260,7 +315,7 @@ func DeleteDatabaseInDatabase(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Database deleted successfully")
 }
 
-func ListDatabasesInDatabase(_ *cobra.Command, args []string) {
+func ListDatabasesInDatabase(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -276,6 +331,7 @@ func ListDatabasesInDatabase(_ *cobra.Command, args []string) {
 	}
 
 	common.ManageListRequest(
+		cmd.Context(),
 		fmt.Sprintf("/v1/cloud/project/%s/database/%s/%s/database", projectID, url.PathEscape(databaseService["engine"].(string)), url.PathEscape(args[0])),
 		"",
 		[]string{"id", "name", "default"},
@@ -6,6 +6,8 @@ package cloud
 
 import (
 	"bufio"
+	"cmp"
+	"context"
 	_ "embed"
 	"encoding/json"
 	"errors"
@@ -23,6 +25,7 @@ import (
 	"github.com/ovh/ovhcloud-cli/internal/openapi"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/ovh/ovhcloud-cli/internal/utils"
+	"github.com/ovh/ovhcloud-cli/internal/wait"
 	"github.com/spf13/cobra"
 )
 
@@ -123,13 +126,20 @@ var (
 	}
 )
 
-func ListInstances(_ *cobra.Command, _ []string) {
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_instance":           &cloudInstanceTemplate,
+		"cloud_instance_interface": &cloudInstanceInterfaceTemplate,
+	})
+}
+
+func ListInstances(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
-	common.ManageListRequest(fmt.Sprintf("/v1/cloud/project/%s/instance", projectID), "id", cloudprojectInstanceColumnsToDisplay, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), fmt.Sprintf("/v1/cloud/project/%s/instance", projectID), "id", cloudprojectInstanceColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetInstance(_ *cobra.Command, args []string) {
@@ -141,6 +151,33 @@ func GetInstance(_ *cobra.Command, args []string) {
 	common.ManageObjectRequest(fmt.Sprintf("/v1/cloud/project/%s/instance", projectID), args[0], cloudInstanceTemplate)
 }
 
+// InstanceWaitFailureCondition is the default condition stopping the wait of a instance
+const InstanceWaitFailureCondition = `status=="ERROR"`
+
+func WaitInstance(cmd *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	result, err := wait.ForCondition(
+		cmd.Context(),
+		fmt.Sprintf("instance %s", args[0]),
+		fmt.Sprintf("/v1/cloud/project/%s/instance/%s", projectID, url.PathEscape(args[0])),
+		"status",
+		flags.WaitCondition,
+		cmp.Or(flags.WaitFailureCondition, InstanceWaitFailureCondition),
+		wait.Options{Timeout: time.Hour, PollInterval: 10 * time.Second},
+	)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for instance: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Instance %s matches condition %s", args[0], flags.WaitCondition)
+}
+
 func SetInstanceName(_ *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
@@ -160,7 +197,7 @@ func SetInstanceName(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Instance %s renamed to %s", args[0], args[1])
 }
 
-func StartInstance(_ *cobra.Command, args []string) {
+func StartInstance(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -174,10 +211,21 @@ func StartInstance(_ *cobra.Command, args []string) {
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Instance starting…")
+	if !flags.WaitForTask {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Instance starting…")
+		return
+	}
+
+	result, err := waitForInstanceStatus(cmd.Context(), projectID, args[0], "ACTIVE")
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for instance to be started: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Instance is started")
 }
 
-func StopInstance(_ *cobra.Command, args []string) {
+func StopInstance(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -191,7 +239,18 @@ func StopInstance(_ *cobra.Command, args []string) {
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Instance stopping…")
+	if !flags.WaitForTask {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Instance stopping…")
+		return
+	}
+
+	result, err := waitForInstanceStatus(cmd.Context(), projectID, args[0], "SHUTOFF")
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for instance to be stopped: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Instance is stopped")
 }
 
 func ShelveInstance(_ *cobra.Command, args []string) {
@@ -245,7 +304,7 @@ func ResumeInstance(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Instance is being resumed…")
 }
 
-func RebootInstance(_ *cobra.Command, args []string) {
+func RebootInstance(cmd *cobra.Command, args []string) {
 	if InstanceRebootType != "soft" && InstanceRebootType != "hard" {
 		display.OutputError(&flags.OutputFormatConfig, "invalid reboot type: %q. Use 'soft' or 'hard'.", InstanceRebootType)
 		return
@@ -267,7 +326,18 @@ func RebootInstance(_ *cobra.Command, args []string) {
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Instance is rebooting…")
+	if !flags.WaitForTask {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Instance is rebooting…")
+		return
+	}
+
+	result, err := waitForInstanceStatus(cmd.Context(), projectID, args[0], "ACTIVE")
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for instance to be rebooted: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Instance is rebooted")
 }
 
 func CreateInstance(cmd *cobra.Command, args []string) {
@@ -320,7 +390,7 @@ func CreateInstance(cmd *cobra.Command, args []string) {
 	log.Println("⚡️ Instance creation started…")
 
 	operationID := operation["id"].(string)
-	instanceID, err := waitForCloudOperation(projectID, operationID, "instance#create", time.Hour)
+	instanceID, err := waitForCloudOperation(cmd.Context(), projectID, operationID, "instance#create", time.Hour)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to wait for instance creation: %s", err)
 		return
@@ -555,36 +625,27 @@ func ReinstallInstance(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	if err := waitForInstanceStatus(projectID, args[0], "ACTIVE"); err != nil {
+	result, err := waitForInstanceStatus(cmd.Context(), projectID, args[0], "ACTIVE")
+	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to wait for instance to be reinstalled: %s", err)
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Reinstallation done")
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Reinstallation done")
 }
 
-func waitForInstanceStatus(cloudProject, instanceID, targetStatus string) error {
+func waitForInstanceStatus(ctx context.Context, cloudProject, instanceID, targetStatus string) (*wait.Result, error) {
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/instance/%s", cloudProject, url.PathEscape(instanceID))
 
-	for range 100 {
-		var instance map[string]any
-
-		if err := httpLib.Client.Get(endpoint, &instance); err != nil {
-			return fmt.Errorf("failed to fetch instance: %w", err)
-		}
-
-		switch instance["status"] {
-		case targetStatus:
-			return nil
-		case "ERROR":
-			return fmt.Errorf("invalid state for instance: %s", instance["status"])
-		default:
-			log.Printf("Still waiting for instance to be in state 'ACTIVE' (status=%s)…", instance["status"])
-			time.Sleep(30 * time.Second)
-		}
-	}
-
-	return fmt.Errorf("timeout waiting for instance %s to be in state 'ACTIVE'", instanceID)
+	return wait.ForResourceStatus(
+		ctx,
+		fmt.Sprintf("instance %s to be in state %s", instanceID, targetStatus),
+		endpoint,
+		"status",
+		[]string{targetStatus},
+		[]string{"ERROR"},
+		wait.Options{Timeout: time.Hour, PollInterval: 15 * time.Second},
+	)
 }
 
 func ActivateMonthlyBilling(_ *cobra.Command, args []string) {
@@ -604,7 +665,7 @@ func ActivateMonthlyBilling(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Monthly billing activated for instance %q", args[0])
 }
 
-func ListInstanceInterfaces(_ *cobra.Command, args []string) {
+func ListInstanceInterfaces(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -613,7 +674,7 @@ func ListInstanceInterfaces(_ *cobra.Command, args []string) {
 
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/instance/%s/interface", projectID, url.PathEscape(args[0]))
 
-	common.ManageListRequestNoExpand(endpoint, []string{"id", "type", "macAddress", "networkId", "state"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"id", "type", "macAddress", "networkId", "state"}, flags.GenericFilters)
 }
 
 func GetInstanceInterface(_ *cobra.Command, args []string) {
@@ -670,7 +731,7 @@ func DeleteInstanceInterface(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Interface deleted successfully")
 }
 
-func EnableInstanceInRescueMode(_ *cobra.Command, args []string) {
+func EnableInstanceInRescueMode(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -698,15 +759,16 @@ func EnableInstanceInRescueMode(_ *cobra.Command, args []string) {
 		return
 	}
 
-	if err := waitForInstanceStatus(projectID, args[0], "RESCUE"); err != nil {
+	result, err := waitForInstanceStatus(cmd.Context(), projectID, args[0], "RESCUE")
+	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to wait for instance to be in rescue mode %s", err)
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Instance is now in rescue mode")
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Instance is now in rescue mode")
 }
 
-func DisableInstanceRescueMode(_ *cobra.Command, args []string) {
+func DisableInstanceRescueMode(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -730,15 +792,16 @@ func DisableInstanceRescueMode(_ *cobra.Command, args []string) {
 		return
 	}
 
-	if err := waitForInstanceStatus(projectID, args[0], "ACTIVE"); err != nil {
+	result, err := waitForInstanceStatus(cmd.Context(), projectID, args[0], "ACTIVE")
+	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to wait for instance to exit rescue mode %s", err)
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Instance is no longer in rescue mode")
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Instance is no longer in rescue mode")
 }
 
-func SetInstanceFlavor(_ *cobra.Command, args []string) {
+func SetInstanceFlavor(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -798,12 +861,13 @@ func SetInstanceFlavor(_ *cobra.Command, args []string) {
 		return
 	}
 
-	if err := waitForInstanceStatus(projectID, args[0], "ACTIVE"); err != nil {
+	result, err := waitForInstanceStatus(cmd.Context(), projectID, args[0], "ACTIVE")
+	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to wait for instance to migrate to the desired flavor: %s", err)
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Instance correctly migrated to the desired flavor")
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Instance correctly migrated to the desired flavor")
 }
 
 func CreateInstanceSnapshot(_ *cobra.Command, args []string) {
@@ -860,14 +924,14 @@ func AbortInstanceSnapshot(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Snapshot aborted successfully")
 }
 
-func ListInstanceSnapshots(_ *cobra.Command, _ []string) {
+func ListInstanceSnapshots(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	common.ManageListRequestNoExpand(fmt.Sprintf("/v1/cloud/project/%s/snapshot", projectID), []string{"id", "name", "type", "status", "region"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), fmt.Sprintf("/v1/cloud/project/%s/snapshot", projectID), []string{"id", "name", "type", "status", "region"}, flags.GenericFilters)
 }
 
 func GetInstanceSnapshot(_ *cobra.Command, args []string) {
@@ -24,6 +24,12 @@ var (
 	cloudIPFailoverTemplate string
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_ip_failover": &cloudIPFailoverTemplate,
+	})
+}
+
 func ListCloudIPFailovers(_ *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
@@ -5,6 +5,8 @@
 package cloud
 
 import (
+	"cmp"
+	"context"
 	_ "embed"
 	"encoding/json"
 	"errors"
@@ -12,6 +14,7 @@ import (
 	"log"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/ovh/ovhcloud-cli/internal/assets"
 	"github.com/ovh/ovhcloud-cli/internal/display"
@@ -19,9 +22,14 @@ import (
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/ovh/ovhcloud-cli/internal/wait"
 	"github.com/spf13/cobra"
 )
 
+// kubeStatusUpdateDelay is the time to wait before checking the status of a cluster
+// after an action, as its status is not updated right after the request
+const kubeStatusUpdateDelay = 15 * time.Second
+
 var (
 	cloudprojectKubeColumnsToDisplay = []string{"id", "name", "region", "plan", "version", "status"}
 
@@ -121,6 +129,16 @@ var (
 	KubeIPRestrictions []string
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_kube":               &cloudKubeTemplate,
+		"cloud_kube_customization": &cloudKubeCustomizationTemplate,
+		"cloud_kube_node":          &cloudKubeNodeTemplate,
+		"cloud_kube_nodepool":      &cloudKubeNodepoolTemplate,
+		"cloud_kube_oidc":          &cloudKubeOIDCTemplate,
+	})
+}
+
 type kubeNodepoolSpec struct {
 	AntiAffinity bool `json:"antiAffinity,omitempty"`
 	Autoscale    bool `json:"autoscale,omitempty"`
@@ -162,14 +180,14 @@ type kubeNodepoolSpecTaintType struct {
 	Value  string `json:"value,omitempty"`
 }
 
-func ListKubes(_ *cobra.Command, _ []string) {
+func ListKubes(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	common.ManageListRequest(fmt.Sprintf("/v1/cloud/project/%s/kube", projectID), "", cloudprojectKubeColumnsToDisplay, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), fmt.Sprintf("/v1/cloud/project/%s/kube", projectID), "", cloudprojectKubeColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetKube(_ *cobra.Command, args []string) {
@@ -229,7 +247,58 @@ func CreateKube(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, cluster, "✅ Cluster %s created successfully (id: %s)", cluster["name"], cluster["id"])
+	if !flags.WaitForTask {
+		display.OutputInfo(&flags.OutputFormatConfig, cluster, "✅ Cluster %s created successfully (id: %s)", cluster["name"], cluster["id"])
+		return
+	}
+
+	result, err := waitForKubeReady(cmd.Context(), projectID, fmt.Sprint(cluster["id"]), 0)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for Kubernetes cluster to be ready: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Cluster %s created successfully and ready (id: %s)", cluster["name"], cluster["id"])
+}
+
+// waitForKubeReady waits for the given Kubernetes cluster to be in READY state
+func waitForKubeReady(ctx context.Context, projectID, kubeID string, initialDelay time.Duration) (*wait.Result, error) {
+	return wait.ForResourceStatus(
+		ctx,
+		fmt.Sprintf("Kubernetes cluster %s to be ready", kubeID),
+		fmt.Sprintf("/v1/cloud/project/%s/kube/%s", projectID, url.PathEscape(kubeID)),
+		"status",
+		[]string{"READY"},
+		[]string{"ERROR", "USER_ERROR", "USER_QUOTA_ERROR"},
+		wait.Options{Timeout: time.Hour, PollInterval: 10 * time.Second, InitialDelay: initialDelay},
+	)
+}
+
+// KubeWaitFailureCondition is the default condition stopping the wait of a Kubernetes cluster
+const KubeWaitFailureCondition = `status in ["ERROR", "USER_ERROR", "USER_QUOTA_ERROR"]`
+
+func WaitKube(cmd *cobra.Command, args []string) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	result, err := wait.ForCondition(
+		cmd.Context(),
+		fmt.Sprintf("Kubernetes cluster %s", args[0]),
+		fmt.Sprintf("/v1/cloud/project/%s/kube/%s", projectID, url.PathEscape(args[0])),
+		"status",
+		flags.WaitCondition,
+		cmp.Or(flags.WaitFailureCondition, KubeWaitFailureCondition),
+		wait.Options{Timeout: time.Hour, PollInterval: 10 * time.Second},
+	)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for Kubernetes cluster: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Kubernetes cluster %s matches condition %s", args[0], flags.WaitCondition)
 }
 
 func EditKube(cmd *cobra.Command, args []string) {
@@ -303,7 +372,7 @@ func EditKubeCustomization(cmd *cobra.Command, args []string) {
 	}
 }
 
-func ListKubeIPRestrictions(_ *cobra.Command, args []string) {
+func ListKubeIPRestrictions(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -312,7 +381,7 @@ func ListKubeIPRestrictions(_ *cobra.Command, args []string) {
 
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/kube/%s/ipRestrictions", projectID, url.PathEscape(args[0]))
 
-	body, err := httpLib.FetchArray(endpoint, "")
+	body, err := httpLib.FetchArray(cmd.Context(), endpoint, "")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch IP restrictions: %s", err)
 		return
@@ -422,7 +491,7 @@ func ResetKubeConfig(cmd *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Kube config reset successfully")
 }
 
-func ListKubeNodes(_ *cobra.Command, args []string) {
+func ListKubeNodes(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -431,7 +500,7 @@ func ListKubeNodes(_ *cobra.Command, args []string) {
 
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/kube/%s/node", projectID, url.PathEscape(args[0]))
 
-	common.ManageListRequestNoExpand(endpoint, []string{"id", "name", "flavor", "version", "status"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"id", "name", "flavor", "version", "status"}, flags.GenericFilters)
 }
 
 func GetKubeNode(_ *cobra.Command, args []string) {
@@ -462,7 +531,7 @@ func DeleteKubeNode(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ MKS node deleted successfully")
 }
 
-func ListKubeNodepools(_ *cobra.Command, args []string) {
+func ListKubeNodepools(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -471,7 +540,7 @@ func ListKubeNodepools(_ *cobra.Command, args []string) {
 
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/kube/%s/nodepool", projectID, url.PathEscape(args[0]))
 
-	common.ManageListRequestNoExpand(endpoint, []string{"id", "name", "flavor", "currentNodes", "status"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"id", "name", "flavor", "currentNodes", "status"}, flags.GenericFilters)
 }
 
 func GetKubeNodepool(_ *cobra.Command, args []string) {
@@ -760,7 +829,18 @@ func ResetKubeCluster(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Kubernetes cluster is being reset…")
+	if !flags.WaitForTask {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Kubernetes cluster is being reset…")
+		return
+	}
+
+	result, err := waitForKubeReady(cmd.Context(), projectID, args[0], kubeStatusUpdateDelay)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for Kubernetes cluster to be reset: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Kubernetes cluster reset done")
 }
 
 func RestartKubeCluster(_ *cobra.Command, args []string) {
@@ -780,7 +860,7 @@ func RestartKubeCluster(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Kubernetes cluster restarting…")
 }
 
-func UpdateKubeCluster(_ *cobra.Command, args []string) {
+func UpdateKubeCluster(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -801,7 +881,18 @@ func UpdateKubeCluster(_ *cobra.Command, args []string) {
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Kubernetes cluster update in progress…")
+	if !flags.WaitForTask {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Kubernetes cluster update in progress…")
+		return
+	}
+
+	result, err := waitForKubeReady(cmd.Context(), projectID, args[0], kubeStatusUpdateDelay)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for Kubernetes cluster to be updated: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Kubernetes cluster update done")
 }
 
 func UpdateKubeLoadBalancersSubnet(_ *cobra.Command, args []string) {
@@ -5,6 +5,7 @@
 package cloud
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
 	"net/url"
@@ -31,9 +32,15 @@ var (
 	}
 )
 
-func locateLoadbalancer(projectID, loadbalancerID string) (string, map[string]any, error) {
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_loadbalancer": &cloudLoadbalancerTemplate,
+	})
+}
+
+func locateLoadbalancer(ctx context.Context, projectID, loadbalancerID string) (string, map[string]any, error) {
 	// Fetch regions with loadbalancer feature available
-	regions, err := getCloudRegionsWithFeatureAvailable(projectID, "octavialoadbalancer")
+	regions, err := getCloudRegionsWithFeatureAvailable(ctx, projectID, "octavialoadbalancer")
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to fetch regions with loadbalancer feature available: %w", err)
 	}
@@ -52,7 +59,7 @@ func locateLoadbalancer(projectID, loadbalancerID string) (string, map[string]an
 	return "", nil, fmt.Errorf("no loadbalancer found with id %s", loadbalancerID)
 }
 
-func ListCloudLoadbalancers(_ *cobra.Command, _ []string) {
+func ListCloudLoadbalancers(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -60,7 +67,7 @@ func ListCloudLoadbalancers(_ *cobra.Command, _ []string) {
 	}
 
 	// Fetch regions with loadbalancer feature available
-	regions, err := getCloudRegionsWithFeatureAvailable(projectID, "octavialoadbalancer")
+	regions, err := getCloudRegionsWithFeatureAvailable(cmd.Context(), projectID, "octavialoadbalancer")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch regions with loadbalancer feature available: %s", err)
 		return
@@ -68,7 +75,7 @@ func ListCloudLoadbalancers(_ *cobra.Command, _ []string) {
 
 	// Fetch loadbalancers in all regions
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region", projectID)
-	containers, err := httpLib.FetchObjectsParallel[[]map[string]any](endpoint+"/%s/loadbalancing/loadbalancer", regions, true)
+	containers, err := httpLib.FetchObjectsParallel[[]map[string]any](cmd.Context(), endpoint+"/%s/loadbalancing/loadbalancer", regions, true)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch loadbalancers: %s", err)
 		return
@@ -90,7 +97,7 @@ func ListCloudLoadbalancers(_ *cobra.Command, _ []string) {
 	display.RenderTable(allLoadbalancers, cloudprojectLoadbalancerColumnsToDisplay, &flags.OutputFormatConfig)
 }
 
-func GetCloudLoadbalancer(_ *cobra.Command, args []string) {
+func GetCloudLoadbalancer(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -98,7 +105,7 @@ func GetCloudLoadbalancer(_ *cobra.Command, args []string) {
 	}
 
 	// Find and fetch the loadbalancer
-	region, lb, err := locateLoadbalancer(projectID, args[0])
+	region, lb, err := locateLoadbalancer(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -125,7 +132,7 @@ func EditCloudLoadbalancer(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	region, _, err := locateLoadbalancer(projectID, args[0])
+	region, _, err := locateLoadbalancer(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
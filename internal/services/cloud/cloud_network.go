@@ -5,6 +5,7 @@
 package cloud
 
 import (
+	"context"
 	_ "embed"
 	"errors"
 	"fmt"
@@ -117,6 +118,14 @@ var (
 	}
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_network_private": &cloudNetworkPrivateTemplate,
+		"cloud_network_public":  &cloudNetworkPublicTemplate,
+		"cloud_network_gateway": &cloudGatewayTemplate,
+	})
+}
+
 type (
 	PrivateNetworkAllocationPool struct {
 		Start string `json:"start,omitempty"`
@@ -135,18 +144,19 @@ type (
 
 	PrivateNetwork struct {
 		ID      string                 `json:"id"`
+		Name    string                 `json:"name"`
 		Regions []NetworkRegionDetails `json:"regions"`
 	}
 )
 
-func ListPrivateNetworks(_ *cobra.Command, _ []string) {
+func ListPrivateNetworks(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	body, err := httpLib.FetchExpandedArray(fmt.Sprintf("/v1/cloud/project/%s/network/private", projectID), "id")
+	body, err := httpLib.FetchExpandedArray(cmd.Context(), fmt.Sprintf("/v1/cloud/project/%s/network/private", projectID), "id")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch results: %s", err)
 		return
@@ -298,7 +308,7 @@ You can check the status of the operation with: 'ovhcloud cloud operation get %[
 		return
 	}
 
-	networkID, err := waitForCloudOperation(projectID, task["id"].(string), "network#create", 10*time.Minute)
+	networkID, err := waitForCloudOperation(cmd.Context(), projectID, task["id"].(string), "network#create", 10*time.Minute)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to wait for network creation: %s", err)
 		return
@@ -430,7 +440,7 @@ func AddPrivateNetworkRegion(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Private network %s region %s added successfully", args[0], args[1])
 }
 
-func ListPrivateNetworkSubnets(_ *cobra.Command, args []string) {
+func ListPrivateNetworkSubnets(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -439,7 +449,7 @@ func ListPrivateNetworkSubnets(_ *cobra.Command, args []string) {
 
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/network/private/%s/subnet", projectID, url.PathEscape(args[0]))
 
-	common.ManageListRequestNoExpand(endpoint, []string{"id", "cidr", "gatewayIp", "dhcpEnabled"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"id", "cidr", "gatewayIp", "dhcpEnabled"}, flags.GenericFilters)
 }
 
 func GetPrivateNetworkSubnet(_ *cobra.Command, args []string) {
@@ -605,7 +615,7 @@ func GetPublicNetwork(_ *cobra.Command, args []string) {
 	display.OutputObject(object, args[0], cloudNetworkPublicTemplate, &flags.OutputFormatConfig)
 }
 
-func ListGateways(_ *cobra.Command, _ []string) {
+func ListGateways(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -613,7 +623,7 @@ func ListGateways(_ *cobra.Command, _ []string) {
 	}
 
 	// Fetch regions with network feature available
-	regions, err := getCloudRegionsWithFeatureAvailable(projectID, "network")
+	regions, err := getCloudRegionsWithFeatureAvailable(cmd.Context(), projectID, "network")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch regions with network feature available: %s", err)
 		return
@@ -621,7 +631,7 @@ func ListGateways(_ *cobra.Command, _ []string) {
 
 	// Fetch gateways in all regions
 	url := fmt.Sprintf("/v1/cloud/project/%s/region", projectID)
-	gateways, err := httpLib.FetchObjectsParallel[[]map[string]any](url+"/%s/gateway", regions, true)
+	gateways, err := httpLib.FetchObjectsParallel[[]map[string]any](cmd.Context(), url+"/%s/gateway", regions, true)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch gateways: %s", err)
 		return
@@ -643,14 +653,14 @@ func ListGateways(_ *cobra.Command, _ []string) {
 	display.RenderTable(allGateways, cloudprojectGatewayColumnsToDisplay, &flags.OutputFormatConfig)
 }
 
-func findGateway(gatewayId string) (string, map[string]any, error) {
+func findGateway(ctx context.Context, gatewayId string) (string, map[string]any, error) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		return "", nil, err
 	}
 
 	// Fetch regions with network feature available
-	regions, err := getCloudRegionsWithFeatureAvailable(projectID, "network")
+	regions, err := getCloudRegionsWithFeatureAvailable(ctx, projectID, "network")
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to fetch regions with network feature available: %w", err)
 	}
@@ -671,8 +681,8 @@ func findGateway(gatewayId string) (string, map[string]any, error) {
 	return "", nil, errors.New("no gateway found with given ID")
 }
 
-func GetGateway(_ *cobra.Command, args []string) {
-	_, foundGateway, err := findGateway(args[0])
+func GetGateway(cmd *cobra.Command, args []string) {
+	_, foundGateway, err := findGateway(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -682,7 +692,7 @@ func GetGateway(_ *cobra.Command, args []string) {
 }
 
 func EditGateway(cmd *cobra.Command, args []string) {
-	foundURL, _, err := findGateway(args[0])
+	foundURL, _, err := findGateway(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -767,7 +777,7 @@ You can check the status of the operation with: 'ovhcloud cloud operation get %s
 		return
 	}
 
-	gatewayID, err := waitForCloudOperation(projectID, task["id"].(string), "gateway#create", 30*time.Minute)
+	gatewayID, err := waitForCloudOperation(cmd.Context(), projectID, task["id"].(string), "gateway#create", 30*time.Minute)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to wait for gateway creation: %s", err)
 		return
@@ -776,8 +786,8 @@ You can check the status of the operation with: 'ovhcloud cloud operation get %s
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Gateway %s created successfully", gatewayID)
 }
 
-func DeleteGateway(_ *cobra.Command, args []string) {
-	foundURL, _, err := findGateway(args[0])
+func DeleteGateway(cmd *cobra.Command, args []string) {
+	foundURL, _, err := findGateway(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -791,8 +801,8 @@ func DeleteGateway(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Gateway %s deleted successfully", args[0])
 }
 
-func ExposeGateway(_ *cobra.Command, args []string) {
-	foundURL, _, err := findGateway(args[0])
+func ExposeGateway(cmd *cobra.Command, args []string) {
+	foundURL, _, err := findGateway(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -814,18 +824,18 @@ func ExposeGateway(_ *cobra.Command, args []string) {
 	display.OutputObject(object, args[0], cloudGatewayTemplate, &flags.OutputFormatConfig)
 }
 
-func ListGatewayInterfaces(_ *cobra.Command, args []string) {
-	foundURL, _, err := findGateway(args[0])
+func ListGatewayInterfaces(cmd *cobra.Command, args []string) {
+	foundURL, _, err := findGateway(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	common.ManageListRequestNoExpand(foundURL+"/interface", []string{"id", "ip", "networkId", "subnetId"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), foundURL+"/interface", []string{"id", "ip", "networkId", "subnetId"}, flags.GenericFilters)
 }
 
-func GetGatewayInterface(_ *cobra.Command, args []string) {
-	foundURL, _, err := findGateway(args[0])
+func GetGatewayInterface(cmd *cobra.Command, args []string) {
+	foundURL, _, err := findGateway(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -834,8 +844,8 @@ func GetGatewayInterface(_ *cobra.Command, args []string) {
 	common.ManageObjectRequest(foundURL+"/interface", args[1], "")
 }
 
-func CreateGatewayInterface(_ *cobra.Command, args []string) {
-	foundURL, _, err := findGateway(args[0])
+func CreateGatewayInterface(cmd *cobra.Command, args []string) {
+	foundURL, _, err := findGateway(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -853,8 +863,8 @@ func CreateGatewayInterface(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Gateway %s interface created successfully", args[0])
 }
 
-func DeleteGatewayInterface(_ *cobra.Command, args []string) {
-	foundURL, _, err := findGateway(args[0])
+func DeleteGatewayInterface(cmd *cobra.Command, args []string) {
+	foundURL, _, err := findGateway(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
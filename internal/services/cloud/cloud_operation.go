@@ -23,6 +23,12 @@ var (
 	cloudOperationTemplate string
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_operation": &cloudOperationTemplate,
+	})
+}
+
 func ListCloudOperations(_ *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
@@ -5,6 +5,7 @@
 package cloud
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
 	"net/url"
@@ -43,8 +44,14 @@ var (
 	}
 )
 
-func ListCloudProject(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/cloud/project", "", cloudprojectColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_project": &cloudProjectTemplate,
+	})
+}
+
+func ListCloudProject(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/cloud/project", "", cloudprojectColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetCloudProject(_ *cobra.Command, args []string) {
@@ -90,8 +97,20 @@ func getConfiguredCloudProject() (string, error) {
 	return url.PathEscape(projectID), nil
 }
 
-func getCloudRegionsWithFeatureAvailable(projectID string, features ...string) ([]any, error) {
-	regions, err := fetchProjectRegions(projectID)
+// GetConfiguredCloudProject returns the ID of the cloud project given with --cloud-project,
+// in the environment or in the configuration, for the commands of other services
+func GetConfiguredCloudProject() (string, error) {
+	return getConfiguredCloudProject()
+}
+
+// GetCloudRegionsWithFeatureAvailable returns the regions of the given project where one
+// of the given features is available, for the commands of other services
+func GetCloudRegionsWithFeatureAvailable(ctx context.Context, projectID string, features ...string) ([]any, error) {
+	return getCloudRegionsWithFeatureAvailable(ctx, projectID, features...)
+}
+
+func getCloudRegionsWithFeatureAvailable(ctx context.Context, projectID string, features ...string) ([]any, error) {
+	regions, err := fetchProjectRegions(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -117,10 +136,10 @@ func getCloudRegionsWithFeatureAvailable(projectID string, features ...string) (
 	return regionIDs, nil
 }
 
-func fetchProjectRegions(projectID string) ([]map[string]any, error) {
+func fetchProjectRegions(ctx context.Context, projectID string) ([]map[string]any, error) {
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region", projectID)
 
-	regions, err := httpLib.FetchExpandedArray(endpoint, "")
+	regions, err := httpLib.FetchExpandedArray(ctx, endpoint, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch regions: %w", err)
 	}
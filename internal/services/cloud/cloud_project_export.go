@@ -0,0 +1,509 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/ghodss/yaml"
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/ovh/ovhcloud-cli/internal/assets"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/openapi"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// CloudProjectExportDir is the directory where the resources of a project are exported
+	CloudProjectExportDir string
+
+	// exportFileNameRegexp matches the characters replaced in the names of the exported files
+	exportFileNameRegexp = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+)
+
+// cloudExportKind describes how the resources of a kind are exported
+type cloudExportKind struct {
+	// dir is the directory of the documents of the kind
+	dir string
+
+	// fetch returns the resources of the kind
+	fetch func(ctx context.Context) ([]map[string]any, error)
+
+	// path returns the path of the creation operation of the given resource in the
+	// API schema, whose parameters are the fields kept in the exported document
+	path func(resource map[string]any) string
+
+	// normalize converts the given resource to the parameters of its creation
+	normalize func(ctx context.Context, resource map[string]any) (map[string]any, error)
+
+	// keep are the fields kept in the exported document even if they are not parameters
+	// of the creation, like the region given in the path of the operation
+	keep []string
+
+	// nameField is the field used to name the file of a resource, "name" by default
+	nameField string
+}
+
+// cloudExporter exports the resources of a cloud project
+type cloudExporter struct {
+	projectID string
+
+	// networks are the private networks of the project, fetched once
+	networks []map[string]any
+}
+
+func ExportCloudProject(cmd *cobra.Command, args []string) {
+	var (
+		exporter = &cloudExporter{projectID: args[0]}
+		dir      = cmp.Or(CloudProjectExportDir, args[0])
+		files    []string
+	)
+
+	for _, kind := range exporter.kinds() {
+		resources, err := kind.fetch(cmd.Context())
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to export %s: %s", kind.dir, err)
+			return
+		}
+
+		usedNames := make(map[string]bool)
+		for _, resource := range resources {
+			document, err := exporter.document(cmd.Context(), kind, resource)
+			if err != nil {
+				display.OutputError(&flags.OutputFormatConfig, "failed to export %s %s: %s", kind.dir, resource["id"], err)
+				return
+			}
+
+			// Resources having the same name are suffixed by their ID
+			name := exportFileName(fmt.Sprint(cmp.Or(resource[cmp.Or(kind.nameField, "name")], resource["id"])))
+			if usedNames[name] {
+				name += "-" + exportFileName(fmt.Sprint(resource["id"]))
+			}
+			usedNames[name] = true
+
+			path := filepath.Join(dir, kind.dir, name+".yaml")
+			if err := writeExportDocument(path, document); err != nil {
+				display.OutputError(&flags.OutputFormatConfig, "failed to write %s: %s", path, err)
+				return
+			}
+			files = append(files, path)
+		}
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, files, "✅ %d resources of project %s exported to %s", len(files), args[0], dir)
+}
+
+// kinds returns the kinds of exported resources
+func (e *cloudExporter) kinds() []cloudExportKind {
+	return []cloudExportKind{
+		{
+			dir:       "instances",
+			fetch:     e.fetchList("/instance"),
+			path:      exportPath("/cloud/project/{serviceName}/instance"),
+			normalize: normalizeExportedInstance,
+		},
+		{
+			dir:   "volumes",
+			fetch: e.fetchList("/volume"),
+			path:  exportPath("/cloud/project/{serviceName}/region/{regionName}/volume"),
+			keep:  []string{"region"},
+		},
+		{
+			dir:       "snapshots",
+			fetch:     e.fetchList("/snapshot"),
+			path:      exportPath("/cloud/project/{serviceName}/instance/{instanceId}/snapshot"),
+			normalize: normalizeExportedSnapshot,
+			keep:      []string{"region"},
+		},
+		{
+			dir:   "volume-snapshots",
+			fetch: e.fetchList("/volume/snapshot"),
+			path:  exportPath("/cloud/project/{serviceName}/volume/{volumeId}/snapshot"),
+			keep:  []string{"region", "volumeId"},
+		},
+		{
+			dir:       "networks",
+			fetch:     e.fetchNetworks,
+			path:      exportPath("/cloud/project/{serviceName}/network/private"),
+			normalize: normalizeExportedNetwork,
+		},
+		{
+			dir:       "subnets",
+			fetch:     e.fetchSubnets,
+			path:      exportPath("/cloud/project/{serviceName}/network/private/{networkId}/subnet"),
+			normalize: normalizeExportedSubnet,
+			keep:      []string{"privateNetwork"},
+		},
+		{
+			dir:   "gateways",
+			fetch: e.fetchRegionalList("/gateway", "network"),
+			path:  exportPath("/cloud/project/{serviceName}/region/{regionName}/gateway"),
+			keep:  []string{"region"},
+		},
+		{
+			dir:   "loadbalancers",
+			fetch: e.fetchRegionalList("/loadbalancing/loadbalancer", "octavialoadbalancer"),
+			path:  exportPath("/cloud/project/{serviceName}/region/{regionName}/loadbalancing/loadbalancer"),
+			keep:  []string{"region"},
+		},
+		{
+			dir:       "kube-clusters",
+			fetch:     e.fetchExpandedList("/kube"),
+			path:      exportPath("/cloud/project/{serviceName}/kube"),
+			normalize: e.normalizeExportedKubeCluster,
+			keep:      []string{"nodepools", "openIdConnect", "ipRestrictions"},
+		},
+		{
+			dir:   "databases",
+			fetch: e.fetchExpandedList("/database/service"),
+			path: func(resource map[string]any) string {
+				return "/cloud/project/{serviceName}/database/" + url.PathEscape(fmt.Sprint(resource["engine"]))
+			},
+			normalize: normalizeExportedDatabase,
+			keep:      []string{"engine"},
+			nameField: "description",
+		},
+		{
+			dir:       "container-registries",
+			fetch:     e.fetchList("/containerRegistry"),
+			path:      exportPath("/cloud/project/{serviceName}/containerRegistry"),
+			normalize: e.normalizeExportedContainerRegistry,
+		},
+		{
+			dir:       "s3-containers",
+			fetch:     e.fetchRegionalList("/storage", "storage-s3-high-perf", "storage-s3-standard"),
+			path:      exportPath("/cloud/project/{serviceName}/region/{regionName}/storage"),
+			normalize: e.normalizeExportedS3Container,
+			keep:      []string{"region", "lifecycle"},
+		},
+		{
+			dir:       "users",
+			fetch:     e.fetchList("/user"),
+			path:      exportPath("/cloud/project/{serviceName}/user"),
+			normalize: normalizeExportedUser,
+			nameField: "username",
+		},
+		{
+			dir:       "alerting",
+			fetch:     e.fetchExpandedList("/alerting"),
+			path:      exportPath("/cloud/project/{serviceName}/alerting"),
+			nameField: "id",
+		},
+	}
+}
+
+// document returns the editable document exported for the given resource
+func (e *cloudExporter) document(ctx context.Context, kind cloudExportKind, resource map[string]any) (map[string]any, error) {
+	source := resource
+	if kind.normalize != nil {
+		var err error
+		if source, err = kind.normalize(ctx, resource); err != nil {
+			return nil, err
+		}
+	}
+
+	document, err := openapi.FilterEditableFields(assets.CloudOpenapiSchema, kind.path(resource), "post", source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract writable properties: %w", err)
+	}
+
+	for _, field := range kind.keep {
+		if value, ok := source[field]; ok && value != nil {
+			document[field] = value
+		}
+	}
+
+	return document, nil
+}
+
+func (e *cloudExporter) endpoint(path string) string {
+	return fmt.Sprintf("/v1/cloud/project/%s%s", url.PathEscape(e.projectID), path)
+}
+
+// fetchList returns a function fetching the list of objects returned by the given endpoint of the project
+func (e *cloudExporter) fetchList(path string) func(context.Context) ([]map[string]any, error) {
+	return func(ctx context.Context) ([]map[string]any, error) {
+		var resources []map[string]any
+		if err := httpLib.Client.GetWithContext(ctx, e.endpoint(path), &resources); err != nil {
+			return nil, err
+		}
+		return resources, nil
+	}
+}
+
+// fetchExpandedList returns a function fetching the objects whose IDs are returned by
+// the given endpoint of the project
+func (e *cloudExporter) fetchExpandedList(path string) func(context.Context) ([]map[string]any, error) {
+	return func(ctx context.Context) ([]map[string]any, error) {
+		return httpLib.FetchExpandedArray(ctx, e.endpoint(path), "")
+	}
+}
+
+// fetchRegionalList returns a function fetching the objects returned by the given endpoint
+// in all the regions of the project where one of the given features is available
+func (e *cloudExporter) fetchRegionalList(path string, features ...string) func(context.Context) ([]map[string]any, error) {
+	return func(ctx context.Context) ([]map[string]any, error) {
+		regions, err := getCloudRegionsWithFeatureAvailable(ctx, e.projectID, features...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch regions with feature available: %w", err)
+		}
+
+		regionalResources, err := httpLib.FetchObjectsParallel[[]map[string]any](ctx, e.endpoint("/region")+"/%s"+path, regions, true)
+		if err != nil {
+			return nil, err
+		}
+
+		var resources []map[string]any
+		for _, regionResources := range regionalResources {
+			resources = append(resources, regionResources...)
+		}
+
+		return resources, nil
+	}
+}
+
+func (e *cloudExporter) fetchNetworks(ctx context.Context) ([]map[string]any, error) {
+	if e.networks != nil {
+		return e.networks, nil
+	}
+
+	networks, err := e.fetchList("/network/private")(ctx)
+	if err != nil {
+		return nil, err
+	}
+	e.networks = networks
+
+	return networks, nil
+}
+
+// fetchSubnets returns the subnets of all the private networks, with the name
+// of their network
+func (e *cloudExporter) fetchSubnets(ctx context.Context) ([]map[string]any, error) {
+	networks, err := e.fetchNetworks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var subnets []map[string]any
+	for _, network := range networks {
+		networkSubnets, err := e.fetchList(fmt.Sprintf("/network/private/%s/subnet", url.PathEscape(fmt.Sprint(network["id"]))))(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, subnet := range networkSubnets {
+			subnet["privateNetwork"] = network["name"]
+			subnet["name"] = fmt.Sprintf("%s-%s", network["name"], subnet["cidr"])
+			subnets = append(subnets, subnet)
+		}
+	}
+
+	return subnets, nil
+}
+
+// normalizeExportedInstance converts the billing status of an instance to the
+// monthlyBilling parameter of its creation
+func normalizeExportedInstance(_ context.Context, instance map[string]any) (map[string]any, error) {
+	instance["monthlyBilling"] = instance["monthlyBilling"] != nil
+	return instance, nil
+}
+
+func normalizeExportedSnapshot(_ context.Context, snapshot map[string]any) (map[string]any, error) {
+	snapshot["snapshotName"] = snapshot["name"]
+	return snapshot, nil
+}
+
+// normalizeExportedNetwork converts the regions of a network to the list of region
+// names given at its creation
+func normalizeExportedNetwork(_ context.Context, network map[string]any) (map[string]any, error) {
+	regions, _ := network["regions"].([]any)
+
+	names := make([]any, 0, len(regions))
+	for _, region := range regions {
+		if details, ok := region.(map[string]any); ok {
+			names = append(names, details["region"])
+		}
+	}
+	network["regions"] = names
+
+	return network, nil
+}
+
+// normalizeExportedSubnet converts the first IP pool of a subnet to the parameters of its creation
+func normalizeExportedSubnet(_ context.Context, subnet map[string]any) (map[string]any, error) {
+	subnet["network"] = subnet["cidr"]
+	subnet["noGateway"] = subnet["gatewayIp"] == nil
+
+	if pools, _ := subnet["ipPools"].([]any); len(pools) > 0 {
+		if pool, ok := pools[0].(map[string]any); ok {
+			for _, field := range []string{"dhcp", "start", "end", "region"} {
+				subnet[field] = pool[field]
+			}
+		}
+	}
+
+	return subnet, nil
+}
+
+// normalizeExportedKubeCluster adds the customization, node pools, OpenID Connect integration
+// and IP restrictions of a cluster to its document
+func (e *cloudExporter) normalizeExportedKubeCluster(ctx context.Context, cluster map[string]any) (map[string]any, error) {
+	clusterPath := fmt.Sprintf("/kube/%s", url.PathEscape(fmt.Sprint(cluster["id"])))
+
+	var customization map[string]any
+	if err := httpLib.Client.GetWithContext(ctx, e.endpoint(clusterPath+"/customization"), &customization); err != nil {
+		return nil, fmt.Errorf("failed to fetch customization: %w", err)
+	}
+	cluster["customization"] = customization
+
+	liveNodepools, err := e.fetchList(clusterPath + "/nodepool")(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch node pools: %w", err)
+	}
+
+	nodepools := make([]any, 0, len(liveNodepools))
+	for _, nodepool := range liveNodepools {
+		// The flavor of a node pool is given as flavorName at its creation
+		nodepool["flavorName"] = nodepool["flavor"]
+
+		document, err := openapi.FilterEditableFields(assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/kube/{kubeId}/nodepool", "post", nodepool)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract writable properties of node pool: %w", err)
+		}
+		nodepools = append(nodepools, document)
+	}
+	cluster["nodepools"] = nodepools
+
+	var oidc map[string]any
+	if err := getOptionalObject(ctx, e.endpoint(clusterPath+"/openIdConnect"), &oidc); err != nil {
+		return nil, fmt.Errorf("failed to fetch OpenID Connect integration: %w", err)
+	}
+	if oidc != nil {
+		if cluster["openIdConnect"], err = openapi.FilterEditableFields(assets.CloudOpenapiSchema, "/cloud/project/{serviceName}/kube/{kubeId}/openIdConnect", "post", oidc); err != nil {
+			return nil, fmt.Errorf("failed to extract writable properties of OpenID Connect integration: %w", err)
+		}
+	}
+
+	if cluster["ipRestrictions"], err = httpLib.FetchArray(ctx, e.endpoint(clusterPath+"/ipRestrictions"), ""); err != nil {
+		return nil, fmt.Errorf("failed to fetch IP restrictions: %w", err)
+	}
+
+	return cluster, nil
+}
+
+// normalizeExportedDatabase converts the nodes of a database service to the nodes
+// pattern given at its creation
+func normalizeExportedDatabase(_ context.Context, database map[string]any) (map[string]any, error) {
+	pattern := map[string]any{
+		"flavor": database["flavor"],
+		"number": database["nodeNumber"],
+	}
+	if nodes, _ := database["nodes"].([]any); len(nodes) > 0 {
+		if node, ok := nodes[0].(map[string]any); ok {
+			pattern["region"] = node["region"]
+		}
+	}
+	database["nodesPattern"] = pattern
+
+	return database, nil
+}
+
+// normalizeExportedContainerRegistry adds the ID of the plan of a registry to its document
+func (e *cloudExporter) normalizeExportedContainerRegistry(ctx context.Context, registry map[string]any) (map[string]any, error) {
+	var plan map[string]any
+	endpoint := e.endpoint(fmt.Sprintf("/containerRegistry/%s/plan", url.PathEscape(fmt.Sprint(registry["id"]))))
+	if err := httpLib.Client.GetWithContext(ctx, endpoint, &plan); err != nil {
+		return nil, fmt.Errorf("failed to fetch plan: %w", err)
+	}
+	registry["planID"] = plan["id"]
+
+	return registry, nil
+}
+
+// normalizeExportedS3Container fetches the details and the lifecycle rules of a container
+func (e *cloudExporter) normalizeExportedS3Container(ctx context.Context, container map[string]any) (map[string]any, error) {
+	endpoint := e.endpoint(fmt.Sprintf("/region/%s/storage/%s",
+		url.PathEscape(fmt.Sprint(container["region"])), url.PathEscape(fmt.Sprint(container["name"]))))
+
+	var details map[string]any
+	if err := httpLib.Client.GetWithContext(ctx, endpoint, &details); err != nil {
+		return nil, fmt.Errorf("failed to fetch container details: %w", err)
+	}
+
+	var lifecycle map[string]any
+	if err := getOptionalObject(ctx, endpoint+"/lifecycle", &lifecycle); err != nil {
+		return nil, fmt.Errorf("failed to fetch lifecycle configuration: %w", err)
+	}
+	if lifecycle != nil {
+		var err error
+		if details["lifecycle"], err = openapi.FilterEditableFields(assets.CloudOpenapiSchema,
+			"/cloud/project/{serviceName}/region/{regionName}/storage/{name}/lifecycle", "put", lifecycle); err != nil {
+			return nil, fmt.Errorf("failed to extract writable properties of lifecycle configuration: %w", err)
+		}
+	}
+
+	return details, nil
+}
+
+// normalizeExportedUser converts the roles of a user to the list of role names given at its creation
+func normalizeExportedUser(_ context.Context, user map[string]any) (map[string]any, error) {
+	roles, _ := user["roles"].([]any)
+
+	names := make([]any, 0, len(roles))
+	for _, role := range roles {
+		if details, ok := role.(map[string]any); ok {
+			names = append(names, details["name"])
+		}
+	}
+	user["roles"] = names
+
+	return user, nil
+}
+
+// getOptionalObject fetches the object at the given endpoint, leaving the given
+// value unchanged if it does not exist
+func getOptionalObject(ctx context.Context, endpoint string, value any) error {
+	err := httpLib.Client.GetWithContext(ctx, endpoint, value)
+
+	var apiErr *ovh.APIError
+	if errors.As(err, &apiErr) && apiErr.Code == 404 {
+		return nil
+	}
+
+	return err
+}
+
+func exportPath(path string) func(map[string]any) string {
+	return func(map[string]any) string {
+		return path
+	}
+}
+
+// exportFileName returns the given name with the characters not allowed in file names replaced
+func exportFileName(name string) string {
+	return exportFileNameRegexp.ReplaceAllString(name, "_")
+}
+
+func writeExportDocument(path string, document map[string]any) error {
+	content, err := yaml.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0644)
+}
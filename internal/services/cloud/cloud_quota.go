@@ -20,6 +20,12 @@ var (
 	cloudQuotaTemplate string
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_quota": &cloudQuotaTemplate,
+	})
+}
+
 func GetCloudQuota(_ *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
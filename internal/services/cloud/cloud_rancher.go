@@ -8,12 +8,14 @@ import (
 	_ "embed"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/ovh/ovhcloud-cli/internal/assets"
 	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/ovh/ovhcloud-cli/internal/wait"
 	"github.com/spf13/cobra"
 )
 
@@ -38,6 +40,12 @@ var (
 	}
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_rancher": &cloudRancherTemplate,
+	})
+}
+
 type (
 	//type rancherIPRestriction struct {
 	//	CIDRBlock   string `json:"cidrBlock"`
@@ -50,14 +58,14 @@ type (
 	}
 )
 
-func ListCloudRanchers(_ *cobra.Command, _ []string) {
+func ListCloudRanchers(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	common.ManageListRequestNoExpand(fmt.Sprintf("/v2/publicCloud/project/%s/rancher", projectID), cloudprojectRancherColumnsToDisplay, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), fmt.Sprintf("/v2/publicCloud/project/%s/rancher", projectID), cloudprojectRancherColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetRancher(_ *cobra.Command, args []string) {
@@ -122,7 +130,26 @@ func CreateRancher(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, rancher, "✅ Rancher %s created successfully (id: %s)", RancherSpec.TargetSpec.Name, rancher["id"])
+	if !flags.WaitForTask {
+		display.OutputInfo(&flags.OutputFormatConfig, rancher, "✅ Rancher %s created successfully (id: %s)", RancherSpec.TargetSpec.Name, rancher["id"])
+		return
+	}
+
+	result, err := wait.ForResourceStatus(
+		cmd.Context(),
+		fmt.Sprintf("Rancher %s to be ready", rancher["id"]),
+		fmt.Sprintf("/v2/publicCloud/project/%s/rancher/%s", projectID, url.PathEscape(fmt.Sprint(rancher["id"]))),
+		"resourceStatus",
+		[]string{"READY"},
+		[]string{"ERROR"},
+		wait.Options{Timeout: time.Hour, PollInterval: 10 * time.Second},
+	)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for Rancher to be ready: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Rancher %s created successfully and ready (id: %s)", RancherSpec.TargetSpec.Name, rancher["id"])
 }
 
 func ResetRancherAdminCredentials(_ *cobra.Command, args []string) {
@@ -5,6 +5,7 @@
 package cloud
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
@@ -17,7 +18,7 @@ import (
 	"github.com/spf13/cobra"
 )
 
-func GetFlavors(region string) {
+func GetFlavors(ctx context.Context, region string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -29,10 +30,10 @@ func GetFlavors(region string) {
 		endpoint += "?region=" + url.QueryEscape(region)
 	}
 
-	common.ManageListRequestNoExpand(endpoint, []string{"id", "name", "region", "osType", "available"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(ctx, endpoint, []string{"id", "name", "region", "osType", "available"}, flags.GenericFilters)
 }
 
-func GetImages(region, osType string) {
+func GetImages(ctx context.Context, region, osType string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -49,7 +50,7 @@ func GetImages(region, osType string) {
 
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/image?%s", projectID, query.Encode())
 
-	common.ManageListRequestNoExpand(endpoint, []string{"id", "name", "region", "type", "status"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(ctx, endpoint, []string{"id", "name", "region", "type", "status"}, flags.GenericFilters)
 }
 
 func ListContainerRegistryPlans(_ *cobra.Command, _ []string) {
@@ -150,7 +151,7 @@ func ListRancherAvailableVersions(cmd *cobra.Command, _ []string) {
 		endpoint = fmt.Sprintf("/v2/publicCloud/project/%s/rancher/%s/capabilities/version", projectID, serviceID)
 	}
 
-	common.ManageListRequestNoExpand(endpoint, []string{"name", "status", "message"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"name", "status", "message"}, flags.GenericFilters)
 }
 
 func ListRancherAvailablePlans(cmd *cobra.Command, _ []string) {
@@ -171,7 +172,7 @@ func ListRancherAvailablePlans(cmd *cobra.Command, _ []string) {
 		endpoint = fmt.Sprintf("/v2/publicCloud/project/%s/rancher/%s/capabilities/plan", projectID, serviceID)
 	}
 
-	common.ManageListRequestNoExpand(endpoint, []string{"name", "status", "message"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"name", "status", "message"}, flags.GenericFilters)
 }
 
 func ListDatabasesPlans(_ *cobra.Command, _ []string) {
@@ -287,5 +288,5 @@ func ListLoadbalancerFlavors(cmd *cobra.Command, args []string) {
 	}
 
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/loadbalancing/flavor", projectID, url.PathEscape(args[0]))
-	common.ManageListRequestNoExpand(endpoint, []string{"id", "name", "region"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"id", "name", "region"}, flags.GenericFilters)
 }
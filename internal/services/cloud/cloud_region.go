@@ -21,14 +21,20 @@ var (
 	cloudRegionTemplate string
 )
 
-func ListCloudRegions(_ *cobra.Command, _ []string) {
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_region": &cloudRegionTemplate,
+	})
+}
+
+func ListCloudRegions(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	common.ManageListRequest(fmt.Sprintf("/v1/cloud/project/%s/region", projectID), "", cloudprojectRegionColumnsToDisplay, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), fmt.Sprintf("/v1/cloud/project/%s/region", projectID), "", cloudprojectRegionColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetCloudRegion(_ *cobra.Command, args []string) {
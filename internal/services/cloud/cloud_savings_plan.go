@@ -41,6 +41,12 @@ var (
 	}
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_savings_plan": &cloudSavingsPlanTemplate,
+	})
+}
+
 // savingsPlanOffer represents a subscribable savings plan offer
 type savingsPlanOffer struct {
 	OfferID string `json:"offerId"`
@@ -64,7 +70,7 @@ func getServiceID(projectID string) (int, error) {
 }
 
 // ListSavingsPlans lists all subscribed savings plans for a cloud project
-func ListSavingsPlans(_ *cobra.Command, _ []string) {
+func ListSavingsPlans(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -78,7 +84,7 @@ func ListSavingsPlans(_ *cobra.Command, _ []string) {
 	}
 
 	endpoint := fmt.Sprintf("/v1/services/%d/savingsPlans/subscribed", serviceID)
-	common.ManageListRequestNoExpand(endpoint, cloudSavingsPlanColumnsToDisplay, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, cloudSavingsPlanColumnsToDisplay, flags.GenericFilters)
 }
 
 // GetSavingsPlan retrieves a specific savings plan by ID
@@ -155,7 +161,7 @@ func ListSavingsPlanOffers(cmd *cobra.Command, _ []string) {
 		endpoint = fmt.Sprintf("%s?productCode=%s", endpoint, url.QueryEscape(productCode))
 	}
 
-	offers, err := httpLib.FetchArray(endpoint, "")
+	offers, err := httpLib.FetchArray(cmd.Context(), endpoint, "")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to list savings plan offers: %s", err)
 		return
@@ -171,7 +177,7 @@ func ListSavingsPlanOffers(cmd *cobra.Command, _ []string) {
 		// Fetch 3AZ offers to filter them out
 		threeAZEndpoint := fmt.Sprintf("/v1/services/%d/savingsPlans/subscribable?productCode=%s",
 			serviceID, url.QueryEscape(productCode+" 3AZ"))
-		threeAZOffers, _ := httpLib.FetchArray(threeAZEndpoint, "")
+		threeAZOffers, _ := httpLib.FetchArray(cmd.Context(), threeAZEndpoint, "")
 
 		// Build set of 3AZ offer IDs
 		threeAZIDs := make(map[string]struct{})
@@ -566,7 +572,7 @@ func EditSavingsPlanDisplayName(cmd *cobra.Command, args []string) {
 }
 
 // ListSavingsPlanPeriods lists the period history of a savings plan
-func ListSavingsPlanPeriods(_ *cobra.Command, args []string) {
+func ListSavingsPlanPeriods(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -583,5 +589,5 @@ func ListSavingsPlanPeriods(_ *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/services/%d/savingsPlans/subscribed/%s/periods", serviceID, url.PathEscape(savingsPlanID))
 
 	periodColumns := []string{"id", "periodStartDate startDate", "periodEndDate endDate", "size", "status"}
-	common.ManageListRequestNoExpand(endpoint, periodColumns, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, periodColumns, flags.GenericFilters)
 }
@@ -23,6 +23,12 @@ var (
 	cloudSSHKeyTemplate string
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_ssh_key": &cloudSSHKeyTemplate,
+	})
+}
+
 func ListCloudSSHKeys(_ *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
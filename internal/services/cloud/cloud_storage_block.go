@@ -5,6 +5,7 @@
 package cloud
 
 import (
+	"context"
 	_ "embed"
 	"errors"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/ovh/ovhcloud-cli/internal/wait"
 	"github.com/spf13/cobra"
 )
 
@@ -48,14 +50,20 @@ var (
 	}
 )
 
-func ListCloudVolumes(_ *cobra.Command, _ []string) {
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_volume": &volumeTemplate,
+	})
+}
+
+func ListCloudVolumes(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	common.ManageListRequestNoExpand(fmt.Sprintf("/v1/cloud/project/%s/volume", projectID), volumeColumnsToDisplay, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), fmt.Sprintf("/v1/cloud/project/%s/volume", projectID), volumeColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetVolume(_ *cobra.Command, args []string) {
@@ -115,7 +123,7 @@ You can check the status of the operation with: 'ovhcloud cloud operation get %[
 		return
 	}
 
-	volumeID, err := waitForCloudOperation(projectID, task["id"].(string), "ablockstorage.CreateVolume", 10*time.Minute)
+	volumeID, err := waitForCloudOperation(cmd.Context(), projectID, task["id"].(string), "ablockstorage.CreateVolume", 10*time.Minute)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to wait for volume creation: %s", err)
 		return
@@ -140,7 +148,7 @@ func DeleteVolume(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Volume %s deleted successfully", args[0])
 }
 
-func AttachVolumeToInstance(_ *cobra.Command, args []string) {
+func AttachVolumeToInstance(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -156,10 +164,29 @@ func AttachVolumeToInstance(_ *cobra.Command, args []string) {
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Volume %s attached to instance %s successfully", args[0], args[1])
+	if !flags.WaitForTask {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Volume %s attached to instance %s successfully", args[0], args[1])
+		return
+	}
+
+	result, err := wait.ForResourceStatus(
+		cmd.Context(),
+		fmt.Sprintf("volume %s to be attached", args[0]),
+		fmt.Sprintf("/v1/cloud/project/%s/volume/%s", projectID, url.PathEscape(args[0])),
+		"status",
+		[]string{"in-use"},
+		[]string{"error", "error_attaching"},
+		wait.Options{Timeout: 10 * time.Minute, PollInterval: 5 * time.Second},
+	)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for volume to be attached: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Volume %s attached to instance %s successfully", args[0], args[1])
 }
 
-func DetachVolumeFromInstance(_ *cobra.Command, args []string) {
+func DetachVolumeFromInstance(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -175,7 +202,26 @@ func DetachVolumeFromInstance(_ *cobra.Command, args []string) {
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Volume %s detached from instance %s successfully", args[0], args[1])
+	if !flags.WaitForTask {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Volume %s detached from instance %s successfully", args[0], args[1])
+		return
+	}
+
+	result, err := wait.ForResourceStatus(
+		cmd.Context(),
+		fmt.Sprintf("volume %s to be detached", args[0]),
+		fmt.Sprintf("/v1/cloud/project/%s/volume/%s", projectID, url.PathEscape(args[0])),
+		"status",
+		[]string{"available"},
+		[]string{"error", "error_detaching"},
+		wait.Options{Timeout: 10 * time.Minute, PollInterval: 5 * time.Second},
+	)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for volume to be detached: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Volume %s detached from instance %s successfully", args[0], args[1])
 }
 
 func CreateVolumeSnapshot(_ *cobra.Command, args []string) {
@@ -216,7 +262,7 @@ func ListVolumeSnapshots(cmd *cobra.Command, args []string) {
 		flags.GenericFilters = append(flags.GenericFilters, fmt.Sprintf("volumeId==%q", volume))
 	}
 
-	common.ManageListRequestNoExpand(endpoint, []string{"id", "name", "region", "description", "status"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"id", "name", "region", "description", "status"}, flags.GenericFilters)
 }
 
 func DeleteVolumeSnapshot(_ *cobra.Command, args []string) {
@@ -265,14 +311,14 @@ func UpsizeVolume(cmd *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Volume %s upscaled successfully to %dGB", args[0], size)
 }
 
-func findVolumeBackup(backupId string) (string, map[string]any, error) {
+func findVolumeBackup(ctx context.Context, backupId string) (string, map[string]any, error) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		return "", nil, err
 	}
 
 	// Fetch regions with volume feature available
-	regions, err := getCloudRegionsWithFeatureAvailable(projectID, "volume")
+	regions, err := getCloudRegionsWithFeatureAvailable(ctx, projectID, "volume")
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to fetch regions with volume feature available: %s", err)
 	}
@@ -293,7 +339,7 @@ func findVolumeBackup(backupId string) (string, map[string]any, error) {
 	return "", nil, errors.New("no volume backup found with given ID")
 }
 
-func ListVolumeBackups(_ *cobra.Command, args []string) {
+func ListVolumeBackups(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -301,7 +347,7 @@ func ListVolumeBackups(_ *cobra.Command, args []string) {
 	}
 
 	// Fetch regions with volume feature available
-	regions, err := getCloudRegionsWithFeatureAvailable(projectID, "volume")
+	regions, err := getCloudRegionsWithFeatureAvailable(cmd.Context(), projectID, "volume")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch regions with volume feature available: %s", err)
 		return
@@ -309,7 +355,7 @@ func ListVolumeBackups(_ *cobra.Command, args []string) {
 
 	// Fetch volumes in all regions
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region", projectID)
-	volumeBackups, err := httpLib.FetchObjectsParallel[[]map[string]any](endpoint+"/%s/volumeBackup", regions, true)
+	volumeBackups, err := httpLib.FetchObjectsParallel[[]map[string]any](cmd.Context(), endpoint+"/%s/volumeBackup", regions, true)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch volume backups: %s", err)
 		return
@@ -331,8 +377,8 @@ func ListVolumeBackups(_ *cobra.Command, args []string) {
 	display.RenderTable(allVolumeBackups, []string{"id", "name", "region", "status"}, &flags.OutputFormatConfig)
 }
 
-func GetVolumeBackup(_ *cobra.Command, args []string) {
-	_, backup, err := findVolumeBackup(args[0])
+func GetVolumeBackup(cmd *cobra.Command, args []string) {
+	_, backup, err := findVolumeBackup(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -341,8 +387,8 @@ func GetVolumeBackup(_ *cobra.Command, args []string) {
 	display.OutputObject(backup, args[0], "", &flags.OutputFormatConfig)
 }
 
-func DeleteVolumeBackup(_ *cobra.Command, args []string) {
-	endpoint, _, err := findVolumeBackup(args[0])
+func DeleteVolumeBackup(cmd *cobra.Command, args []string) {
+	endpoint, _, err := findVolumeBackup(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -390,8 +436,8 @@ func CreateVolumeBackup(cmd *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, response, "✅ Volume backup for volume %s created successfully (id: %s)", args[0], response["id"])
 }
 
-func RestoreVolumeBackup(_ *cobra.Command, args []string) {
-	endpoint, _, err := findVolumeBackup(args[0])
+func RestoreVolumeBackup(cmd *cobra.Command, args []string) {
+	endpoint, _, err := findVolumeBackup(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -410,7 +456,7 @@ func RestoreVolumeBackup(_ *cobra.Command, args []string) {
 }
 
 func CreateVolumeFromBackup(cmd *cobra.Command, args []string) {
-	endpoint, _, err := findVolumeBackup(args[0])
+	endpoint, _, err := findVolumeBackup(cmd.Context(), args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
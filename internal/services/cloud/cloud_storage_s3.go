@@ -5,6 +5,7 @@
 package cloud
 
 import (
+	"context"
 	_ "embed"
 	"encoding/json"
 	"fmt"
@@ -144,9 +145,16 @@ var (
 	}
 )
 
-func locateStorageS3Container(projectID, containerName string) (string, map[string]any, error) {
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_storage_s3":        &cloudStorageS3Template,
+		"cloud_storage_s3_object": &cloudStorageS3ObjectTemplate,
+	})
+}
+
+func locateStorageS3Container(ctx context.Context, projectID, containerName string) (string, map[string]any, error) {
 	// Fetch regions with storage feature available
-	regions, err := getCloudRegionsWithFeatureAvailable(projectID, "storage-s3-high-perf", "storage-s3-standard")
+	regions, err := getCloudRegionsWithFeatureAvailable(ctx, projectID, "storage-s3-high-perf", "storage-s3-standard")
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to fetch regions with storage feature available: %w", err)
 	}
@@ -165,7 +173,7 @@ func locateStorageS3Container(projectID, containerName string) (string, map[stri
 	return "", nil, fmt.Errorf("no storage container found with name %s", containerName)
 }
 
-func ListCloudStorageS3(_ *cobra.Command, _ []string) {
+func ListCloudStorageS3(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -173,7 +181,7 @@ func ListCloudStorageS3(_ *cobra.Command, _ []string) {
 	}
 
 	// Fetch regions with storage feature available
-	regions, err := getCloudRegionsWithFeatureAvailable(projectID, "storage-s3-high-perf", "storage-s3-standard")
+	regions, err := getCloudRegionsWithFeatureAvailable(cmd.Context(), projectID, "storage-s3-high-perf", "storage-s3-standard")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch regions with storage feature available: %s", err)
 		return
@@ -181,7 +189,7 @@ func ListCloudStorageS3(_ *cobra.Command, _ []string) {
 
 	// Fetch containers in all regions
 	url := fmt.Sprintf("/v1/cloud/project/%s/region", projectID)
-	containers, err := httpLib.FetchObjectsParallel[[]map[string]any](url+"/%s/storage", regions, true)
+	containers, err := httpLib.FetchObjectsParallel[[]map[string]any](cmd.Context(), url+"/%s/storage", regions, true)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch storage containers: %s", err)
 		return
@@ -203,14 +211,14 @@ func ListCloudStorageS3(_ *cobra.Command, _ []string) {
 	display.RenderTable(allContainers, cloudprojectStorageS3ColumnsToDisplay, &flags.OutputFormatConfig)
 }
 
-func GetStorageS3(_ *cobra.Command, args []string) {
+func GetStorageS3(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	_, foundContainer, err := locateStorageS3Container(projectID, args[0])
+	_, foundContainer, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -234,7 +242,7 @@ func EditStorageS3(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -281,14 +289,14 @@ func CreateStorageS3(cmd *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, container, "✅ Container %s created successfully", container["name"])
 }
 
-func DeleteStorageS3(_ *cobra.Command, args []string) {
+func DeleteStorageS3(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -302,14 +310,14 @@ func DeleteStorageS3(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Storage container %s deleted successfully", args[0])
 }
 
-func StorageS3BulkDeleteObjects(_ *cobra.Command, args []string) {
+func StorageS3BulkDeleteObjects(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -400,14 +408,14 @@ func StorageS3BulkDeleteObjects(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Objects deleted successfully")
 }
 
-func ListStorageS3Objects(_ *cobra.Command, args []string) {
+func ListStorageS3Objects(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -432,17 +440,17 @@ func ListStorageS3Objects(_ *cobra.Command, args []string) {
 
 	endpoint := fmt.Sprintf("%s/object?%s", foundURL, params.Encode())
 
-	common.ManageListRequestNoExpand(endpoint, []string{"key", "size"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"key", "size"}, flags.GenericFilters)
 }
 
-func GetStorageS3Object(_ *cobra.Command, args []string) {
+func GetStorageS3Object(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -458,7 +466,7 @@ func EditStorageS3Object(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -476,14 +484,14 @@ func EditStorageS3Object(cmd *cobra.Command, args []string) {
 	}
 }
 
-func DeleteStorageS3Object(_ *cobra.Command, args []string) {
+func DeleteStorageS3Object(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -497,14 +505,14 @@ func DeleteStorageS3Object(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Object %s deleted successfully", args[1])
 }
 
-func ListStorageS3ObjectVersions(_ *cobra.Command, args []string) {
+func ListStorageS3ObjectVersions(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -520,17 +528,17 @@ func ListStorageS3ObjectVersions(_ *cobra.Command, args []string) {
 
 	endpoint := fmt.Sprintf("%s/object/%s/version?%s", foundURL, url.PathEscape(args[1]), params.Encode())
 
-	common.ManageListRequestNoExpand(endpoint, []string{"versionId", "size", "isLatest"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"versionId", "size", "isLatest"}, flags.GenericFilters)
 }
 
-func GetStorageS3ObjectVersion(_ *cobra.Command, args []string) {
+func GetStorageS3ObjectVersion(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -548,7 +556,7 @@ func EditStorageS3ObjectVersion(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -566,14 +574,14 @@ func EditStorageS3ObjectVersion(cmd *cobra.Command, args []string) {
 	}
 }
 
-func DeleteStorageS3ObjectVersion(_ *cobra.Command, args []string) {
+func DeleteStorageS3ObjectVersion(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -594,7 +602,7 @@ func StorageS3GeneratePresignedURL(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -633,7 +641,7 @@ func StorageS3AddUser(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -653,7 +661,7 @@ func StorageS3AddUser(cmd *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ User %s successfully added to the bucket", args[1])
 }
 
-func ListStorageS3Credentials(_ *cobra.Command, args []string) {
+func ListStorageS3Credentials(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
@@ -661,7 +669,7 @@ func ListStorageS3Credentials(_ *cobra.Command, args []string) {
 	}
 
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/user/%s/s3Credentials", projectID, url.PathEscape(args[0]))
-	common.ManageListRequestNoExpand(endpoint, []string{"access", "userId", "tenantId"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"access", "userId", "tenantId"}, flags.GenericFilters)
 }
 
 func CreateStorageS3Credentials(cmd *cobra.Command, args []string) {
@@ -723,14 +731,14 @@ func GetStorageS3Credentials(_ *cobra.Command, args []string) {
 
 // Lifecycle management
 
-func GetStorageS3Lifecycle(_ *cobra.Command, args []string) {
+func GetStorageS3Lifecycle(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -752,7 +760,7 @@ func EditStorageS3Lifecycle(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -770,14 +778,14 @@ func EditStorageS3Lifecycle(cmd *cobra.Command, args []string) {
 	}
 }
 
-func DeleteStorageS3Lifecycle(_ *cobra.Command, args []string) {
+func DeleteStorageS3Lifecycle(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -793,14 +801,14 @@ func DeleteStorageS3Lifecycle(_ *cobra.Command, args []string) {
 
 // Object copy and restore
 
-func CopyStorageS3Object(_ *cobra.Command, args []string) {
+func CopyStorageS3Object(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -816,14 +824,14 @@ func CopyStorageS3Object(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Object %s copied successfully", args[1])
 }
 
-func RestoreStorageS3Object(_ *cobra.Command, args []string) {
+func RestoreStorageS3Object(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -840,14 +848,14 @@ func RestoreStorageS3Object(_ *cobra.Command, args []string) {
 
 // Object version copy and restore
 
-func CopyStorageS3ObjectVersion(_ *cobra.Command, args []string) {
+func CopyStorageS3ObjectVersion(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -863,14 +871,14 @@ func CopyStorageS3ObjectVersion(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ Object %s version %s copied successfully", args[1], args[2])
 }
 
-func RestoreStorageS3ObjectVersion(_ *cobra.Command, args []string) {
+func RestoreStorageS3ObjectVersion(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
@@ -887,14 +895,14 @@ func RestoreStorageS3ObjectVersion(_ *cobra.Command, args []string) {
 
 // Replication job
 
-func CreateStorageS3ReplicationJob(_ *cobra.Command, args []string) {
+func CreateStorageS3ReplicationJob(cmd *cobra.Command, args []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	foundURL, _, err := locateStorageS3Container(projectID, args[0])
+	foundURL, _, err := locateStorageS3Container(cmd.Context(), projectID, args[0])
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
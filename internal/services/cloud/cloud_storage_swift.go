@@ -26,14 +26,20 @@ var (
 	CloudSwiftContainerType string
 )
 
-func ListCloudStorageSwift(_ *cobra.Command, _ []string) {
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_storage_swift": &cloudStorageSwiftTemplate,
+	})
+}
+
+func ListCloudStorageSwift(cmd *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "%s", err)
 		return
 	}
 
-	common.ManageListRequestNoExpand(fmt.Sprintf("/v1/cloud/project/%s/storage", projectID), cloudprojectStorageSwiftColumnsToDisplay, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), fmt.Sprintf("/v1/cloud/project/%s/storage", projectID), cloudprojectStorageSwiftColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetStorageSwift(_ *cobra.Command, args []string) {
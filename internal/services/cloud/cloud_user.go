@@ -47,6 +47,12 @@ var (
 	}
 )
 
+func init() {
+	display.RegisterTemplates("cloud", map[string]*string{
+		"cloud_user": &cloudUserTemplate,
+	})
+}
+
 func ListCloudUsers(_ *cobra.Command, _ []string) {
 	projectID, err := getConfiguredCloudProject()
 	if err != nil {
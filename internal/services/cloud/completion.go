@@ -0,0 +1,259 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/ovh/ovhcloud-cli/internal/assets"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/spf13/cobra"
+)
+
+// CompleteBillingPeriod completes the billing period of instances
+var CompleteBillingPeriod = common.CompleteFromEnum(assets.CloudOpenapiSchema,
+	"/cloud/project/{serviceName}/region/{regionName}/instance", "post", "billingPeriod")
+
+// completeProjectEndpoint returns a function completing the first argument of a command
+// with the IDs returned by the given endpoint of the configured cloud project
+func completeProjectEndpoint(format, idField string, expand bool) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		projectID, err := getConfiguredCloudProject()
+		if err != nil {
+			cobra.CompDebugln(err.Error(), false)
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return common.CompleteFromEndpoint(fmt.Sprintf(format, projectID), idField, expand)(cmd, args, toComplete)
+	}
+}
+
+// CompleteInstanceID completes the ID of the instances of the cloud project
+func CompleteInstanceID(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return completeProjectEndpoint("/v1/cloud/project/%s/instance", "id", false)(cmd, args, toComplete)
+}
+
+// CompleteKubeClusterID completes the ID of the Kubernetes clusters of the cloud project
+func CompleteKubeClusterID(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return completeProjectEndpoint("/v1/cloud/project/%s/kube", "id", true)(cmd, args, toComplete)
+}
+
+// CompleteDatabaseClusterID completes the ID of the database clusters of the cloud project
+func CompleteDatabaseClusterID(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return completeProjectEndpoint("/v1/cloud/project/%s/database/service", "id", true)(cmd, args, toComplete)
+}
+
+// CompleteStorageS3ContainerName completes the name of the S3 containers of the
+// cloud project, in all the regions having the storage feature available
+func CompleteStorageS3ContainerName(cmd *cobra.Command, args []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region", projectID)
+
+	return common.CachedCompletions(cmd, endpoint+"/storage", func(ctx context.Context) ([]cobra.Completion, error) {
+		regions, err := getCloudRegionsWithFeatureAvailable(ctx, projectID, "storage-s3-high-perf", "storage-s3-standard")
+		if err != nil {
+			return nil, err
+		}
+
+		containers, err := httpLib.FetchObjectsParallel[[]map[string]any](ctx, endpoint+"/%s/storage", regions, true)
+		if err != nil {
+			return nil, err
+		}
+
+		var completions []cobra.Completion
+		for _, regionContainers := range containers {
+			for _, container := range regionContainers {
+				if name, ok := container["name"].(string); ok {
+					completions = append(completions, cobra.CompletionWithDesc(name, fmt.Sprint(container["region"])))
+				}
+			}
+		}
+
+		return completions, nil
+	})
+}
+
+// CompleteRegion completes the regions of the cloud project
+func CompleteRegion(cmd *cobra.Command, _ []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/region", projectID)
+
+	return common.CachedCompletions(cmd, endpoint, func(ctx context.Context) ([]cobra.Completion, error) {
+		return common.FetchCompletions(ctx, endpoint, "", false)
+	})
+}
+
+// CompleteContainerRegistryRegion completes the regions where container registries are available
+func CompleteContainerRegistryRegion(cmd *cobra.Command, _ []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/capabilities/containerRegistry", projectID)
+
+	return common.CachedCompletions(cmd, endpoint, func(ctx context.Context) ([]cobra.Completion, error) {
+		var capabilities []map[string]any
+		if err := httpLib.Client.GetWithContext(ctx, endpoint, &capabilities); err != nil {
+			return nil, err
+		}
+
+		completions := make([]cobra.Completion, 0, len(capabilities))
+		for _, capability := range capabilities {
+			if name, ok := capability["regionName"].(string); ok {
+				completions = append(completions, name)
+			}
+		}
+
+		return completions, nil
+	})
+}
+
+// CompleteInstanceFlavor completes the ID of the instance flavors, in the
+// region given as first argument when present
+func CompleteInstanceFlavor(cmd *cobra.Command, args []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/flavor", projectID)
+	if len(args) > 0 {
+		endpoint += "?region=" + url.QueryEscape(args[0])
+	}
+
+	return common.CachedCompletions(cmd, endpoint, func(ctx context.Context) ([]cobra.Completion, error) {
+		var flavors []map[string]any
+		if err := httpLib.Client.GetWithContext(ctx, endpoint, &flavors); err != nil {
+			return nil, err
+		}
+
+		completions := make([]cobra.Completion, 0, len(flavors))
+		for _, flavor := range flavors {
+			if available, ok := flavor["available"].(bool); ok && !available {
+				continue
+			}
+			completions = append(completions, cobra.CompletionWithDesc(fmt.Sprint(flavor["id"]),
+				fmt.Sprintf("%s (%s)", flavor["name"], flavor["region"])))
+		}
+
+		return completions, nil
+	})
+}
+
+// CompleteDatabaseFlavor completes the name of the flavors of database nodes
+func CompleteDatabaseFlavor(cmd *cobra.Command, _ []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/database/capabilities", projectID)
+
+	return common.CachedCompletions(cmd, endpoint+"#flavors", func(ctx context.Context) ([]cobra.Completion, error) {
+		var capabilities struct {
+			Flavors []map[string]any `json:"flavors"`
+		}
+		if err := httpLib.Client.GetWithContext(ctx, endpoint, &capabilities); err != nil {
+			return nil, err
+		}
+
+		completions := make([]cobra.Completion, 0, len(capabilities.Flavors))
+		for _, flavor := range capabilities.Flavors {
+			completions = append(completions, cobra.CompletionWithDesc(fmt.Sprint(flavor["name"]),
+				fmt.Sprintf("%v vCores", flavor["core"])))
+		}
+
+		return completions, nil
+	})
+}
+
+// CompleteLoadbalancerFlavor completes the ID of the flavors available in the
+// region of the loadbalancer given as first argument
+func CompleteLoadbalancerFlavor(cmd *cobra.Command, args []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	key := fmt.Sprintf("/v1/cloud/project/%s/loadbalancing/loadbalancer/%s#flavors", projectID, url.PathEscape(args[0]))
+
+	return common.CachedCompletions(cmd, key, func(ctx context.Context) ([]cobra.Completion, error) {
+		region, _, err := locateLoadbalancer(ctx, projectID, args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint := fmt.Sprintf("/v1/cloud/project/%s/region/%s/loadbalancing/flavor", projectID, url.PathEscape(region))
+
+		var flavors []map[string]any
+		if err := httpLib.Client.GetWithContext(ctx, endpoint, &flavors); err != nil {
+			return nil, err
+		}
+
+		completions := make([]cobra.Completion, 0, len(flavors))
+		for _, flavor := range flavors {
+			completions = append(completions, cobra.CompletionWithDesc(fmt.Sprint(flavor["id"]), fmt.Sprint(flavor["name"])))
+		}
+
+		return completions, nil
+	})
+}
+
+// CompleteSavingsPlanFlavor completes the flavors that can be covered by a savings plan,
+// i.e. the names of the instance flavors of the cloud project and rancher
+func CompleteSavingsPlanFlavor(cmd *cobra.Command, _ []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	projectID, err := getConfiguredCloudProject()
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	endpoint := fmt.Sprintf("/v1/cloud/project/%s/flavor", projectID)
+
+	return common.CachedCompletions(cmd, endpoint+"#savingsPlan", func(ctx context.Context) ([]cobra.Completion, error) {
+		var flavors []map[string]any
+		if err := httpLib.Client.GetWithContext(ctx, endpoint, &flavors); err != nil {
+			return nil, err
+		}
+
+		completions := []cobra.Completion{"rancher"}
+		for _, flavor := range flavors {
+			name, ok := flavor["name"].(string)
+			if !ok || slices.Contains(completions, name) {
+				continue
+			}
+			completions = append(completions, name)
+		}
+
+		return completions, nil
+	})
+}
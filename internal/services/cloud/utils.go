@@ -6,14 +6,13 @@ package cloud
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 	"net/url"
 	"time"
 
 	"github.com/ovh/ovhcloud-cli/internal/display"
 	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/wait"
 )
 
 type CloudProjectOperation struct {
@@ -116,25 +115,20 @@ func runFlavorSelector(projectID string, region string) (string, string, error)
 	return selectedFlavor, selectedID, nil
 }
 
-func waitForCloudOperation(projectID, operationID, action string, retryDuration time.Duration) (string, error) {
+func waitForCloudOperation(ctx context.Context, projectID, operationID, action string, timeout time.Duration) (string, error) {
 	endpoint := fmt.Sprintf("/v1/cloud/project/%s/operation/%s", url.PathEscape(projectID), url.PathEscape(operationID))
 	resourceID := ""
 
-	ctx, cancel := context.WithTimeout(context.Background(), retryDuration)
-	defer cancel()
-
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
-
-	for {
+	options := wait.Options{Timeout: timeout, PollInterval: 5 * time.Second}
+	_, err := wait.For(ctx, "operation "+action, options, func(ctx context.Context) (wait.Status, error) {
 		var operation CloudProjectOperation
-		if err := httpLib.Client.Get(endpoint, &operation); err != nil {
-			return "", fmt.Errorf("error fetching operation: %w", err)
+		if err := httpLib.Client.GetWithContext(ctx, endpoint, &operation); err != nil {
+			return wait.Status{}, fmt.Errorf("error fetching operation: %w", err)
 		}
 
 		switch operation.Status {
 		case "in-error":
-			return "", fmt.Errorf("operation %q ended in error", operation.Action)
+			return wait.Status{}, fmt.Errorf("operation %q ended in error", operation.Action)
 		case "completed":
 			if operation.ResourceId != nil {
 				resourceID = *operation.ResourceId
@@ -146,15 +140,11 @@ func waitForCloudOperation(projectID, operationID, action string, retryDuration
 					}
 				}
 			}
-			return resourceID, nil
+			return wait.Status{Value: operation.Status, Done: true}, nil
 		}
 
-		select {
-		case <-ctx.Done():
-			return "", errors.New("timeout waiting for operation to complete")
-		case <-ticker.C:
-			log.Printf("Still waiting for operation to complete (status=%s)…", operation.Status)
-			continue
-		}
-	}
+		return wait.Status{Value: operation.Status}, nil
+	})
+
+	return resourceID, err
 }
@@ -6,13 +6,17 @@ package common
 
 import (
 	"bufio"
+	"context"
 	_ "embed"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/url"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/editor"
@@ -39,25 +43,50 @@ var (
 	}
 )
 
-func ManageListRequest(path, idField string, columnsToDisplay, filters []string) {
-	body, err := httpLib.FetchExpandedArray(path, idField)
-	if err != nil {
-		display.OutputError(&flags.OutputFormatConfig, "failed to fetch results: %s", err)
+func init() {
+	display.RegisterTemplates("common", map[string]*string{
+		"service_info": &ServiceInfoTemplate,
+	})
+}
+
+func ManageListRequest(ctx context.Context, path, idField string, columnsToDisplay, filters []string) {
+	// Render the items as soon as they are fetched when the output format allows it
+	if flags.OutputFormatConfig.IsStreamable() {
+		var count int
+		if err := httpLib.FetchExpandedArrayStream(ctx, path, idField, func(object map[string]any) error {
+			rows, err := filtersLib.FilterLines([]map[string]any{object}, filters)
+			if err != nil {
+				return fmt.Errorf("failed to filter results: %w", err)
+			}
+			for _, row := range rows {
+				if err := display.RenderRow(row, &flags.OutputFormatConfig); err != nil {
+					return err
+				}
+				count++
+			}
+			return nil
+		}); err != nil {
+			if errors.Is(err, context.Canceled) {
+				display.OutputInterrupted(&flags.OutputFormatConfig, "interrupted after displaying %d results", count)
+				return
+			}
+			display.OutputError(&flags.OutputFormatConfig, "failed to fetch results: %s", err)
+		}
 		return
 	}
 
-	body, err = filtersLib.FilterLines(body, filters)
-	if err != nil {
-		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
+	body, err := httpLib.FetchExpandedArray(ctx, path, idField)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch results: %s", err)
 		return
 	}
 
-	display.RenderTable(body, columnsToDisplay, &flags.OutputFormatConfig)
+	renderList(body, err, columnsToDisplay, filters)
 }
 
-func ManageListRequestNoExpand(path string, columnsToDisplay, filters []string) {
-	body, err := httpLib.FetchArray(path, "")
-	if err != nil {
+func ManageListRequestNoExpand(ctx context.Context, path string, columnsToDisplay, filters []string) {
+	body, err := httpLib.FetchArray(ctx, path, "")
+	if err != nil && !errors.Is(err, context.Canceled) {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch results: %s", err)
 		return
 	}
@@ -67,13 +96,24 @@ func ManageListRequestNoExpand(path string, columnsToDisplay, filters []string)
 		objects = append(objects, object.(map[string]any))
 	}
 
-	objects, err = filtersLib.FilterLines(objects, filters)
+	renderList(objects, err, columnsToDisplay, filters)
+}
+
+// renderList filters and displays the given objects. If fetchErr reports that
+// the fetch was interrupted, the objects are the partial results gathered before
+// the interruption, and an interruption status is displayed after them.
+func renderList(objects []map[string]any, fetchErr error, columnsToDisplay, filters []string) {
+	objects, err := filtersLib.FilterLines(objects, filters)
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to filter results: %s", err)
 		return
 	}
 
 	display.RenderTable(objects, columnsToDisplay, &flags.OutputFormatConfig)
+
+	if fetchErr != nil {
+		display.OutputInterrupted(&flags.OutputFormatConfig, "interrupted after displaying %d results", len(objects))
+	}
 }
 
 func ManageObjectRequest(path, objectID, templateContent string) {
@@ -100,6 +140,60 @@ func CreateResource(cmd *cobra.Command, path, endpoint, defaultExample string,
 		return nil, fmt.Errorf("failed to parse arguments from command line: %w", err)
 	}
 
+	parameters, err := ReadInputParameters(func() (map[string]string, error) {
+		return openapi.GetOperationRequestExamples(openapiSpec, path, "post", defaultExample, cliParameters)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Only merge CLI parameters with other ones if not in --editor mode.
+	// In this case, the CLI parameters have already been merged with the
+	// request examples coming from API schemas.
+	if !flags.ParametersViaEditor {
+		if err := utils.MergeMaps(parameters, cliParameters); err != nil {
+			return nil, fmt.Errorf("failed to merge replace values into example: %w", err)
+		}
+	}
+
+	// Check if mandatory fields are present
+	for _, field := range mandatoryFields {
+		if _, ok := parameters[field]; !ok {
+			return nil, fmt.Errorf("mandatory field %q is missing in the parameters\n\n%s", field, cmd.UsageString())
+		}
+	}
+
+	return PostResource(cmd.Context(), path, endpoint, parameters, openapiSpec)
+}
+
+// PostResource validates the given parameters against the schema of the creation
+// operation of the given path, and creates the resource by posting them to the
+// given endpoint.
+func PostResource(ctx context.Context, path, endpoint string, parameters map[string]any, openapiSpec []byte) (map[string]any, error) {
+	if err := validateRequestBody(openapiSpec, path, "post", parameters, nil); err != nil {
+		return nil, err
+	}
+
+	out, err := json.MarshalIndent(parameters, "", " ")
+	if err != nil {
+		return nil, fmt.Errorf("parameters cannot be marshalled: %w", err)
+	}
+
+	log.Println("Final parameters: \n" + string(out))
+
+	var createdResource map[string]any
+	if err := httpLib.Client.PostWithContext(ctx, endpoint, parameters, &createdResource); err != nil {
+		return nil, fmt.Errorf("error creating resource: %w", err)
+	}
+
+	return createdResource, nil
+}
+
+// ReadInputParameters returns the request parameters given through a pipe, with
+// the --editor flag or with the --from-file flag. In --editor mode, the given func
+// is called to fetch the examples the user can choose from before editing. If it is
+// nil, the editor is opened with an empty object.
+func ReadInputParameters(getExamples func() (map[string]string, error)) (map[string]any, error) {
 	parameters := make(map[string]any)
 
 	switch {
@@ -120,18 +214,21 @@ func CreateResource(cmd *cobra.Command, path, endpoint, defaultExample string,
 	case flags.ParametersViaEditor: // Data given through an editor
 		log.Print("Flag --editor used, all other flags will override the example values")
 
-		examples, err := openapi.GetOperationRequestExamples(openapiSpec, path, "post", defaultExample, cliParameters)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch API call examples: %w", err)
-		}
-
-		_, choice, err := display.RunGenericChoicePicker("Please select a creation example", examples, 0)
-		if err != nil {
-			return nil, err
-		}
-
-		if choice == "" {
-			return nil, errors.New("no example selected, exiting…")
+		choice := "{}"
+		if getExamples != nil {
+			examples, err := getExamples()
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch API call examples: %w", err)
+			}
+
+			_, choice, err = display.RunGenericChoicePicker("Please select a creation example", examples, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			if choice == "" {
+				return nil, errors.New("no example selected, exiting…")
+			}
 		}
 
 		newValue, err := editor.EditValueWithEditor([]byte(choice))
@@ -157,35 +254,7 @@ func CreateResource(cmd *cobra.Command, path, endpoint, defaultExample string,
 		}
 	}
 
-	// Only merge CLI parameters with other ones if not in --editor mode.
-	// In this case, the CLI parameters have already been merged with the
-	// request examples coming from API schemas.
-	if !flags.ParametersViaEditor {
-		if err := utils.MergeMaps(parameters, cliParameters); err != nil {
-			return nil, fmt.Errorf("failed to merge replace values into example: %w", err)
-		}
-	}
-
-	// Check if mandatory fields are present
-	for _, field := range mandatoryFields {
-		if _, ok := parameters[field]; !ok {
-			return nil, fmt.Errorf("mandatory field %q is missing in the parameters\n\n%s", field, cmd.UsageString())
-		}
-	}
-
-	out, err := json.MarshalIndent(parameters, "", " ")
-	if err != nil {
-		return nil, fmt.Errorf("parameters cannot be marshalled: %w", err)
-	}
-
-	log.Println("Final parameters: \n" + string(out))
-
-	var createdResource map[string]any
-	if err := httpLib.Client.Post(endpoint, parameters, &createdResource); err != nil {
-		return nil, fmt.Errorf("error creating resource: %w", err)
-	}
-
-	return createdResource, nil
+	return parameters, nil
 }
 
 func EditResource(cmd *cobra.Command, path, url string, cliParams any, openapiSpec []byte) error {
@@ -227,15 +296,82 @@ func EditResource(cmd *cobra.Command, path, url string, cliParams any, openapiSp
 		cliParameters = fileParameters
 	}
 
+	currentBody, editableBody, err := PrepareEdition(cmd.Context(), path, url, cliParameters, openapiSpec)
+	if err != nil {
+		return err
+	}
+
+	// Let the user edit the body if needed
+	if flags.ParametersViaEditor {
+		// Format editable body
+		editableOutput, err := json.MarshalIndent(editableBody, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal writable body: %w", err)
+		}
+
+		// Edit value
+		updatedBody, err := editor.EditValueWithEditor(editableOutput)
+		if err != nil {
+			return fmt.Errorf("failed to edit properties: %w", err)
+		}
+
+		editableBody = make(map[string]any)
+		if err := json.Unmarshal(updatedBody, &editableBody); err != nil {
+			return fmt.Errorf("failed to parse edited properties: %w", err)
+		}
+	}
+
+	// Review the changes before applying them
+	changes := display.ComputeDiff(currentBody, editableBody)
+	if len(changes) == 0 {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "🟠 Resource is already up to date, nothing to edit")
+		return nil
+	}
+
+	interactive := utils.IsInteractiveTerminal()
+	if flags.ShowDiff || interactive {
+		display.RenderDiff(os.Stderr, changes)
+	}
+
+	if interactive && !flags.AssumeYes {
+		confirmed, err := Confirm("Apply these changes?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			display.OutputInfo(&flags.OutputFormatConfig, nil, "🟠 Edition cancelled, resource not updated")
+			return nil
+		}
+	}
+
+	if err := PutResource(cmd.Context(), path, url, editableBody, changes, openapiSpec); err != nil {
+		return err
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Resource updated successfully")
+
+	return nil
+}
+
+// PrepareEdition fetches the resource at the given URL, and returns its current editable
+// fields and the body of the request updating it with the given parameters.
+func PrepareEdition(ctx context.Context, path, url string, parameters map[string]any, openapiSpec []byte) (map[string]any, map[string]any, error) {
 	// Fetch resource
 	var object map[string]any
-	if err := httpLib.Client.Get(url, &object); err != nil {
-		return fmt.Errorf("error fetching resource %s: %w", url, err)
+	if err := httpLib.Client.GetWithContext(ctx, url, &object); err != nil {
+		return nil, nil, fmt.Errorf("error fetching resource %s: %w", url, err)
 	}
 
-	// Merge CLI parameters with the fetched object
-	if err := utils.MergeMaps(object, cliParameters); err != nil {
-		return fmt.Errorf("failed to merge CLI parameters into example: %w", err)
+	// Keep the current editable fields to display the changes, the fetched
+	// object being modified by the merge
+	currentBody, err := editableCopy(openapiSpec, path, object)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to extract writable properties: %w", err)
+	}
+
+	// Merge parameters with the fetched object
+	if err := utils.MergeMaps(object, parameters); err != nil {
+		return nil, nil, fmt.Errorf("failed to merge CLI parameters into example: %w", err)
 	}
 
 	// Filter editable fields from OpenAPI spec
@@ -246,38 +382,97 @@ func EditResource(cmd *cobra.Command, path, url string, cliParams any, openapiSp
 		object,
 	)
 	if err != nil {
-		return fmt.Errorf("failed to extract writable properties: %w", err)
+		return nil, nil, fmt.Errorf("failed to extract writable properties: %w", err)
 	}
 
-	// If editor not needed, update the resource directly
-	if !flags.ParametersViaEditor {
-		if err := httpLib.Client.Put(url, editableBody, nil); err != nil {
-			return fmt.Errorf("failed to update resource: %w", err)
-		}
+	return currentBody, editableBody, nil
+}
 
-		display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Resource updated successfully")
+// PutResource validates the given body against the schema of the update operation of the
+// given path, only reporting the errors related to the given changes, and sends it to the
+// given URL to update the resource. The current values of the resource being sometimes
+// invalid against the schema (e.g. null values), only the changed fields are checked.
+func PutResource(ctx context.Context, path, url string, body map[string]any, changes []display.DiffChange, openapiSpec []byte) error {
+	if err := validateRequestBody(openapiSpec, path, "put", body, changes); err != nil {
+		return err
+	}
 
-		return nil
+	if err := httpLib.Client.PutWithContext(ctx, url, body, nil); err != nil {
+		return fmt.Errorf("failed to update resource: %w", err)
 	}
 
-	// Format editable body
-	editableOutput, err := json.MarshalIndent(editableBody, "", "  ")
+	return nil
+}
+
+// editableCopy returns a deep copy of the editable fields of the given object
+func editableCopy(openapiSpec []byte, path string, object map[string]any) (map[string]any, error) {
+	content, err := json.Marshal(object)
 	if err != nil {
-		return fmt.Errorf("failed to marshal writable body: %w", err)
+		return nil, err
 	}
 
-	// Edit value
-	updatedBody, err := editor.EditValueWithEditor(editableOutput)
-	if err != nil {
-		return fmt.Errorf("failed to edit properties: %w", err)
+	var objectCopy map[string]any
+	if err := json.Unmarshal(content, &objectCopy); err != nil {
+		return nil, err
 	}
 
-	// Update API call
-	if err := httpLib.Client.Put(url, json.RawMessage(updatedBody), nil); err != nil {
-		return fmt.Errorf("failed to update resource: %w", err)
+	return openapi.FilterEditableFields(openapiSpec, path, "put", objectCopy)
+}
+
+// validateRequestBody checks the given body against the schema of the given operation.
+// When changes are given, only the errors related to the changed fields are returned.
+func validateRequestBody(openapiSpec []byte, path, method string, body map[string]any, changes []display.DiffChange) error {
+	if flags.SkipValidation || openapiSpec == nil {
+		return nil
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Resource updated successfully")
+	err := openapi.ValidateRequestBody(openapiSpec, path, method, body)
+	if err == nil {
+		return nil
+	}
 
-	return nil
+	var validationErrs openapi.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		// The schema of the operation could not be used, let the API validate the body
+		log.Printf("Skipping validation of request body: %s", err)
+		return nil
+	}
+
+	if changes != nil {
+		validationErrs = slices.DeleteFunc(validationErrs, func(validationErr openapi.ValidationError) bool {
+			return !slices.ContainsFunc(changes, func(change display.DiffChange) bool {
+				return isSubPath(validationErr.Path, change.Path) || isSubPath(change.Path, validationErr.Path)
+			})
+		})
+		if len(validationErrs) == 0 {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w\n\nUse --skip-validation to send the request anyway", validationErrs)
+}
+
+// isSubPath returns whether the given path is equal to or below the given parent path
+func isSubPath(path, parent string) bool {
+	if parent == "" || path == parent {
+		return true
+	}
+	return strings.HasPrefix(path, parent+".") || strings.HasPrefix(path, parent+"[")
+}
+
+// Confirm asks the given question on the terminal and returns whether the user accepted
+func Confirm(question string) (bool, error) {
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", question)
+
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed to read answer: %w", err)
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
 }
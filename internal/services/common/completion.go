@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/config"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/openapi"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Duration during which the completions fetched from the API are reused
+	completionCacheTTL = 2 * time.Minute
+
+	// Maximum duration of the API calls made to complete a command line
+	completionTimeout = 10 * time.Second
+)
+
+// completionDescriptionFields are the fields used, by order of preference, to
+// describe the IDs proposed as completions
+var completionDescriptionFields = []string{"name", "displayName", "description", "iam.displayName", "region"}
+
+// CompleteFromEndpoint returns a function completing the first argument of a command
+// with the IDs returned by the given endpoint. If the endpoint returns objects, their
+// ID is read in the given field. When expand is true, the objects corresponding to the
+// returned IDs are fetched to describe them.
+func CompleteFromEndpoint(path, idField string, expand bool) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return CachedCompletions(cmd, path, func(ctx context.Context) ([]cobra.Completion, error) {
+			return FetchCompletions(ctx, path, idField, expand)
+		})
+	}
+}
+
+// CompleteFromEnum returns a function completing a flag with the allowed values
+// of the given property of the request body of an operation
+func CompleteFromEnum(spec []byte, path, method, property string) cobra.CompletionFunc {
+	return func(_ *cobra.Command, _ []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		values, err := openapi.GetRequestBodyPropertyEnum(spec, path, method, property)
+		if err != nil {
+			cobra.CompDebugln(err.Error(), false)
+		}
+		return values, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// CachedCompletions returns the completions stored in the on-disk cache under the given
+// key, or the completions returned by fetch when they are not cached or have expired.
+// Errors are not reported, to keep the command line usable.
+func CachedCompletions(cmd *cobra.Command, key string, fetch func(ctx context.Context) ([]cobra.Completion, error)) ([]cobra.Completion, cobra.ShellCompDirective) {
+	cachePath := completionCachePath(key)
+	if completions, ok := readCompletionCache(cachePath); ok {
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	if httpLib.Client == nil {
+		cobra.CompDebugln("API client not initialized, cannot fetch completions", false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+	defer cancel()
+
+	completions, err := fetch(ctx)
+	if err != nil {
+		cobra.CompDebugln(fmt.Sprintf("failed to fetch completions: %s", err), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	writeCompletionCache(cachePath, completions)
+
+	return completions, cobra.ShellCompDirectiveNoFileComp
+}
+
+// FetchCompletions returns the IDs returned by the given endpoint, described by the
+// name of the corresponding objects when available
+func FetchCompletions(ctx context.Context, path, idField string, expand bool) ([]cobra.Completion, error) {
+	items, err := httpLib.FetchArray(ctx, path, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if expand {
+		objects, err := httpLib.FetchObjectsParallel[map[string]any](ctx, path+"/%s", items, true)
+		if err != nil {
+			return nil, err
+		}
+		items = make([]any, 0, len(objects))
+		for _, object := range objects {
+			items = append(items, object)
+		}
+	}
+
+	completions := make([]cobra.Completion, 0, len(items))
+	for _, item := range items {
+		object, ok := item.(map[string]any)
+		if !ok {
+			completions = append(completions, fmt.Sprint(item))
+			continue
+		}
+
+		id, ok := object[idField]
+		if !ok || id == nil {
+			continue
+		}
+		completions = append(completions, CompletionFromObject(fmt.Sprint(id), object))
+	}
+
+	return completions, nil
+}
+
+// CompletionFromObject returns a completion proposing the given ID, described
+// by the name of the given object when it has one
+func CompletionFromObject(id string, object map[string]any) cobra.Completion {
+	for _, field := range completionDescriptionFields {
+		var value any = object
+		for _, key := range strings.Split(field, ".") {
+			nested, ok := value.(map[string]any)
+			if !ok {
+				value = nil
+				break
+			}
+			value = nested[key]
+		}
+
+		if description, ok := value.(string); ok && description != "" && description != id {
+			return cobra.CompletionWithDesc(id, description)
+		}
+	}
+
+	return id
+}
+
+func completionCachePath(key string) string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+
+	// Completions are specific to the account of the profile in use
+	hash := sha256.Sum256([]byte(config.ActiveProfile + "\n" + key))
+
+	return filepath.Join(cacheDir, "ovhcloud", "completion", hex.EncodeToString(hash[:])+".json")
+}
+
+func readCompletionCache(path string) ([]cobra.Completion, bool) {
+	if path == "" {
+		return nil, false
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > completionCacheTTL {
+		return nil, false
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	var completions []cobra.Completion
+	if err := json.Unmarshal(content, &completions); err != nil {
+		return nil, false
+	}
+
+	return completions, true
+}
+
+func writeCompletionCache(path string, completions []cobra.Completion) {
+	if path == "" {
+		return
+	}
+
+	content, err := json.Marshal(completions)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		cobra.CompDebugln(fmt.Sprintf("failed to create completion cache directory: %s", err), false)
+		return
+	}
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		cobra.CompDebugln(fmt.Sprintf("failed to write completion cache: %s", err), false)
+	}
+}
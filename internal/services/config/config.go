@@ -5,10 +5,12 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"maps"
 	"net/url"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/ovh/ovhcloud-cli/internal/config"
@@ -19,10 +21,46 @@ import (
 
 var (
 	validAPIRegions = []string{"EU", "CA", "US"}
+
+	// fieldValidators check the values of the configuration fields that are not free text
+	fieldValidators = map[string]func(value string) error{
+		"max_retries": validateMinimumInteger(0),
+		"parallel":    validateMinimumInteger(1),
+		"rate_limit": func(value string) error {
+			if rate, err := strconv.ParseFloat(value, 64); err != nil || rate < 0 {
+				return errors.New("expected a positive number")
+			}
+			return nil
+		},
+	}
+
+	// ProfileSpec defines the values of a profile to add
+	ProfileSpec struct {
+		Endpoint            string
+		ApplicationKey      string
+		ApplicationSecret   string
+		ConsumerKey         string
+		ClientID            string
+		ClientSecret        string
+		AccessToken         string
+		DefaultCloudProject string
+	}
 )
 
 func ShowConfig(_ *cobra.Command, _ []string) {
-	display.RenderConfigTable(flags.CliConfig)
+	display.RenderConfigTable(flags.CliConfig, func(section, key string) (string, string) {
+		return config.GetEffectiveValue(flags.CliConfig, section, key)
+	})
+}
+
+// validateMinimumInteger returns a function checking that a value is an integer greater than or equal to minimum
+func validateMinimumInteger(minimum int) func(value string) error {
+	return func(value string) error {
+		if number, err := strconv.Atoi(value); err != nil || number < minimum {
+			return fmt.Errorf("expected an integer greater than or equal to %d", minimum)
+		}
+		return nil
+	}
 }
 
 func SetConfig(_ *cobra.Command, args []string) {
@@ -31,35 +69,142 @@ func SetConfig(_ *cobra.Command, args []string) {
 		display.OutputError(&flags.OutputFormatConfig, "unknown configuration field %q, customizable fields are: %s", args[0], allowedKeys)
 		return
 	}
-	if err := config.SetConfigValue(flags.CliConfig, flags.CliConfigPath, "", args[0], args[1]); err != nil {
+	if validate, ok := fieldValidators[args[0]]; ok {
+		if err := validate(args[1]); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "invalid value %q for %s: %s", args[1], args[0], err)
+			return
+		}
+	}
+	if err := config.SetConfigValue(flags.CliConfig, flags.ConfigScope, "", args[0], args[1]); err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to set configuration: %s", err)
 		return
 	}
 }
 
+// ParseEndpoint converts the given region (EU, CA, US) to the name of the
+// corresponding API endpoint, or checks that the given value is a valid URL.
+func ParseEndpoint(value string) (string, error) {
+	if slices.Contains(validAPIRegions, value) {
+		return fmt.Sprintf("ovh-%s", strings.ToLower(value)), nil
+	}
+
+	// Check if given value is a valid URL
+	url, err := url.Parse(value)
+	if err != nil {
+		return "", fmt.Errorf("invalid API endpoint %q, valid values are [EU, CA, US] or a valid URL", value)
+	}
+
+	if url.Scheme != "https" && url.Scheme != "http" {
+		return "", fmt.Errorf(`given url has an invalid scheme, only "http" and "https" are allowed`)
+	}
+
+	return value, nil
+}
+
 func SetEndpoint(_ *cobra.Command, args []string) {
-	var endpoint string
+	endpoint, err := ParseEndpoint(args[0])
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+
+	if err := config.SetConfigValue(flags.CliConfig, flags.ConfigScope, "", "endpoint", endpoint); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to set API endpoint configuration: %s", err)
+		return
+	}
+}
+
+func ListProfiles(_ *cobra.Command, _ []string) {
+	activeProfile := config.ResolveProfile(flags.CliConfig, flags.Profile)
 
-	if slices.Contains(validAPIRegions, args[0]) {
-		endpoint = fmt.Sprintf("ovh-%s", strings.ToLower(args[0]))
-	} else {
-		// Check if given value is a valid URL
-		url, err := url.Parse(args[0])
+	profiles := []map[string]any{}
+	for _, name := range config.ListProfiles(flags.CliConfig) {
+		values, err := config.GetProfile(flags.CliConfig, name)
 		if err != nil {
-			display.OutputError(&flags.OutputFormatConfig, "invalid API endpoint %q, valid values are [EU, CA, US] or a valid URL", args[0])
+			display.OutputError(&flags.OutputFormatConfig, "failed to read profile: %s", err)
 			return
 		}
 
-		if url.Scheme != "https" && url.Scheme != "http" {
-			display.OutputError(&flags.OutputFormatConfig, `given url has an invalid scheme, only "http" and "https" are allowed`)
+		profiles = append(profiles, map[string]any{
+			"name":                  name,
+			"active":                name == activeProfile,
+			"endpoint":              values["endpoint"],
+			"authentication":        profileAuthenticationMethod(values),
+			"default_cloud_project": values["default_cloud_project"],
+		})
+	}
+
+	display.RenderTable(profiles, []string{"name", "active", "endpoint", "authentication", "default_cloud_project"}, &flags.OutputFormatConfig)
+}
+
+// profileAuthenticationMethod returns the authentication method configured in the given profile values
+func profileAuthenticationMethod(values map[string]string) string {
+	switch {
+	case values["client_id"] != "":
+		return "oauth2"
+	case values["access_token"] != "":
+		return "access_token"
+	case values["application_key"] != "":
+		return "application_key"
+	default:
+		return ""
+	}
+}
+
+func AddProfile(_ *cobra.Command, args []string) {
+	name := args[0]
+	if slices.Contains(config.ListProfiles(flags.CliConfig), name) {
+		display.OutputError(&flags.OutputFormatConfig, "profile %q already exists", name)
+		return
+	}
+
+	values := map[string]string{
+		"endpoint":              "ovh-eu",
+		"application_key":       ProfileSpec.ApplicationKey,
+		"application_secret":    ProfileSpec.ApplicationSecret,
+		"consumer_key":          ProfileSpec.ConsumerKey,
+		"client_id":             ProfileSpec.ClientID,
+		"client_secret":         ProfileSpec.ClientSecret,
+		"access_token":          ProfileSpec.AccessToken,
+		"default_cloud_project": ProfileSpec.DefaultCloudProject,
+	}
+	if ProfileSpec.Endpoint != "" {
+		endpoint, err := ParseEndpoint(ProfileSpec.Endpoint)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
 			return
 		}
+		values["endpoint"] = endpoint
+	}
+	maps.DeleteFunc(values, func(_, value string) bool { return value == "" })
 
-		endpoint = args[0]
+	if err := config.SetProfile(flags.CliConfig, flags.ConfigScope, name, values); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to add profile: %s", err)
+		return
 	}
 
-	if err := config.SetConfigValue(flags.CliConfig, flags.CliConfigPath, "", "endpoint", endpoint); err != nil {
-		display.OutputError(&flags.OutputFormatConfig, "failed to set API endpoint configuration: %s", err)
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Profile %s added", name)
+}
+
+func RemoveProfile(_ *cobra.Command, args []string) {
+	if err := config.RemoveProfile(flags.CliConfig, flags.ConfigScope, args[0]); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to remove profile: %s", err)
 		return
 	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Profile %s removed", args[0])
+}
+
+func UseProfile(_ *cobra.Command, args []string) {
+	if !slices.Contains(config.ListProfiles(flags.CliConfig), args[0]) {
+		display.OutputError(&flags.OutputFormatConfig, "profile %q not found", args[0])
+		return
+	}
+
+	if err := config.SetConfigValue(flags.CliConfig, flags.ConfigScope, "", "profile", args[0]); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to set active profile: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Profile %s is now active", args[0])
 }
@@ -28,8 +28,14 @@ var (
 	}
 )
 
-func ListDedicatedCeph(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/dedicated/ceph", "", dedicatedcephColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("dedicatedceph", map[string]*string{
+		"dedicatedceph": &dedicatedcephTemplate,
+	})
+}
+
+func ListDedicatedCeph(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/dedicated/ceph", "", dedicatedcephColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetDedicatedCeph(_ *cobra.Command, args []string) {
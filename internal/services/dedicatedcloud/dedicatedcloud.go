@@ -7,6 +7,7 @@ package dedicatedcloud
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
@@ -19,8 +20,14 @@ var (
 	dedicatedcloudTemplate string
 )
 
-func ListDedicatedCloud(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/dedicatedCloud", "", dedicatedcloudColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("dedicatedcloud", map[string]*string{
+		"dedicatedcloud": &dedicatedcloudTemplate,
+	})
+}
+
+func ListDedicatedCloud(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/dedicatedCloud", "", dedicatedcloudColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetDedicatedCloud(_ *cobra.Command, args []string) {
@@ -7,6 +7,7 @@ package dedicatedcluster
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
@@ -19,8 +20,14 @@ var (
 	dedicatedclusterTemplate string
 )
 
-func ListDedicatedCluster(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/dedicated/cluster", "", dedicatedclusterColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("dedicatedcluster", map[string]*string{
+		"dedicatedcluster": &dedicatedclusterTemplate,
+	})
+}
+
+func ListDedicatedCluster(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/dedicated/cluster", "", dedicatedclusterColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetDedicatedCluster(_ *cobra.Command, args []string) {
@@ -28,8 +28,14 @@ var (
 	}
 )
 
-func ListDedicatedNasHA(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/dedicated/nasha", "", dedicatednashaColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("dedicatednasha", map[string]*string{
+		"dedicatednasha": &dedicatednashaTemplate,
+	})
+}
+
+func ListDedicatedNasHA(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/dedicated/nasha", "", dedicatednashaColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetDedicatedNasHA(_ *cobra.Command, args []string) {
@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ovh/ovhcloud-cli/internal/assets"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	"github.com/ovh/ovhcloud-cli/internal/mockapi"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Address and port the mock API server listens on
+	MockServerHost string
+	MockServerPort int
+)
+
+// openapiSchemas are the embedded API schemas served by the mock API server
+var openapiSchemas = [][]byte{
+	assets.BaremetalOpenapiSchema,
+	assets.CloudOpenapiSchema,
+	assets.CloudV2OpenapiSchema,
+	assets.DedicatedcephOpenapiSchema,
+	assets.DedicatednashaOpenapiSchema,
+	assets.DomainOpenapiSchema,
+	assets.EmaildomainOpenapiSchema,
+	assets.EmailmxplanOpenapiSchema,
+	assets.EmailproOpenapiSchema,
+	assets.HostingprivatedatabaseOpenapiSchema,
+	assets.IamOpenapiSchema,
+	assets.IpOpenapiSchema,
+	assets.IploadbalancingOpenapiSchema,
+	assets.LdpOpenapiSchema,
+	assets.MeOpenapiSchema,
+	assets.OvertheboxOpenapiSchema,
+	assets.OvhcloudconnectOpenapiSchema,
+	assets.PackxdslOpenapiSchema,
+	assets.SmsOpenapiSchema,
+	assets.SslgatewayOpenapiSchema,
+	assets.StoragenetappOpenapiSchema,
+	assets.TelephonyOpenapiSchema,
+	assets.VmwareclouddirectorbackupOpenapiSchema,
+	assets.VmwareclouddirectororganizationOpenapiSchema,
+	assets.VpsOpenapiSchema,
+	assets.VrackOpenapiSchema,
+	assets.VrackservicesOpenapiSchema,
+	assets.WebhostingOpenapiSchema,
+	assets.XdslOpenapiSchema,
+}
+
+func StartMockServer(cmd *cobra.Command, _ []string) {
+	handler, err := mockapi.NewServer(openapiSchemas...)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to load API schemas: %s", err)
+		return
+	}
+
+	address := net.JoinHostPort(MockServerHost, strconv.Itoa(MockServerPort))
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to listen on %s: %s", address, err)
+		return
+	}
+
+	server := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	// Stop the server when the command is interrupted
+	stop := context.AfterFunc(cmd.Context(), func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(ctx)
+	})
+	defer stop()
+
+	endpoint := fmt.Sprintf("http://%s", listener.Addr())
+	fmt.Printf(`🚀 Mock API server listening on %[1]s
+Use it with: ovhcloud config set-endpoint %[1]s
+`, endpoint)
+
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		display.OutputError(&flags.OutputFormatConfig, "mock API server failed: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Mock API server stopped")
+}
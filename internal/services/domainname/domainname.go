@@ -28,8 +28,14 @@ var (
 	}
 )
 
-func ListDomainName(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/domain", "", domainnameColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("domainname", map[string]*string{
+		"domainname": &domainnameTemplate,
+	})
+}
+
+func ListDomainName(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/domain", "", domainnameColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetDomainName(_ *cobra.Command, args []string) {
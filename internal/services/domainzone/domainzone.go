@@ -45,11 +45,17 @@ var (
 	}
 )
 
-func ListDomainZone(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/domain/zone", "", domainzoneColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("domainzone", map[string]*string{
+		"domainzone": &domainzoneTemplate,
+	})
 }
 
-func GetDomainZone(_ *cobra.Command, args []string) {
+func ListDomainZone(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/domain/zone", "", domainzoneColumnsToDisplay, flags.GenericFilters)
+}
+
+func GetDomainZone(cmd *cobra.Command, args []string) {
 	path := fmt.Sprintf("/v1/domain/zone/%s", url.PathEscape(args[0]))
 
 	// Fetch domain zone
@@ -61,7 +67,7 @@ func GetDomainZone(_ *cobra.Command, args []string) {
 
 	// Fetch running tasks
 	path = fmt.Sprintf("/v1/domain/zone/%s/record", url.PathEscape(args[0]))
-	records, err := httpLib.FetchExpandedArray(path, "")
+	records, err := httpLib.FetchExpandedArray(cmd.Context(), path, "")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "error fetching records for %s: %s", args[0], err)
 		return
@@ -76,9 +82,9 @@ func GetRecord(_ *cobra.Command, args []string) {
 	common.ManageObjectRequest(path, args[1], "")
 }
 
-func ListRecords(_ *cobra.Command, args []string) {
+func ListRecords(cmd *cobra.Command, args []string) {
 	path := fmt.Sprintf("/v1/domain/zone/%s/record", url.PathEscape(args[0]))
-	common.ManageListRequest(path, "", recordColumnsToDisplay, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), path, "", recordColumnsToDisplay, flags.GenericFilters)
 }
 
 func RefreshZone(_ *cobra.Command, args []string) {
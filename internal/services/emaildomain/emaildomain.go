@@ -36,19 +36,26 @@ var (
 	}
 )
 
-func ListEmailDomain(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/email/domain", "", emaildomainColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("emaildomain", map[string]*string{
+		"emaildomain": &emaildomainTemplate,
+		"redirection": &redirectionTemplate,
+	})
+}
+
+func ListEmailDomain(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/email/domain", "", emaildomainColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetEmailDomain(_ *cobra.Command, args []string) {
 	common.ManageObjectRequest("/v1/email/domain", args[0], emaildomainTemplate)
 }
 
-func ListRedirections(_ *cobra.Command, args []string) {
+func ListRedirections(cmd *cobra.Command, args []string) {
 	serviceName := args[0]
 	path := fmt.Sprintf("/email/domain/%s/redirection", url.PathEscape(serviceName))
 	columnsToDisplay := []string{"id", "from", "to", "localCopy"}
-	common.ManageListRequest(path, "", columnsToDisplay, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), path, "", columnsToDisplay, flags.GenericFilters)
 }
 
 func GetRedirection(_ *cobra.Command, args []string) {
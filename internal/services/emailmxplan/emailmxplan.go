@@ -45,8 +45,14 @@ var (
 	}
 )
 
-func ListEmailMXPlan(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/email/mxplan", "", emailmxplanColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("emailmxplan", map[string]*string{
+		"emailmxplan": &emailmxplanTemplate,
+	})
+}
+
+func ListEmailMXPlan(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/email/mxplan", "", emailmxplanColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetEmailMXPlan(_ *cobra.Command, args []string) {
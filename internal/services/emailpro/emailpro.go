@@ -45,8 +45,14 @@ var (
 	}
 )
 
-func ListEmailPro(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/email/pro", "", emailproColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("emailpro", map[string]*string{
+		"emailpro": &emailproTemplate,
+	})
+}
+
+func ListEmailPro(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/email/pro", "", emailproColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetEmailPro(_ *cobra.Command, args []string) {
@@ -26,8 +26,14 @@ var (
 	HostingPrivateDatabaseDisplayName string
 )
 
-func ListHostingPrivateDatabase(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/hosting/privateDatabase", "", hostingprivatedatabaseColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("hostingprivatedatabase", map[string]*string{
+		"hostingprivatedatabase": &hostingprivatedatabaseTemplate,
+	})
+}
+
+func ListHostingPrivateDatabase(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/hosting/privateDatabase", "", hostingprivatedatabaseColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetHostingPrivateDatabase(_ *cobra.Command, args []string) {
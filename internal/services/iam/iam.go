@@ -89,6 +89,15 @@ var (
 	}
 )
 
+func init() {
+	display.RegisterTemplates("iam", map[string]*string{
+		"iam_policy":            &iamPolicyTemplate,
+		"iam_permissions_group": &iamPermissionsGroupTemplate,
+		"iam_resource":          &iamResourceTemplate,
+		"iam_resource_group":    &iamResourceGroupTemplate,
+	})
+}
+
 type iamPermission struct {
 	Action string `json:"action"`
 }
@@ -97,8 +106,8 @@ type iamResourceURN struct {
 	URN string `json:"urn"`
 }
 
-func ListIAMPolicies(_ *cobra.Command, _ []string) {
-	common.ManageListRequestNoExpand("/v2/iam/policy", iamPolicyColumnsToDisplay, flags.GenericFilters)
+func ListIAMPolicies(cmd *cobra.Command, _ []string) {
+	common.ManageListRequestNoExpand(cmd.Context(), "/v2/iam/policy", iamPolicyColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetIAMPolicy(_ *cobra.Command, args []string) {
@@ -156,8 +165,8 @@ func DeleteIAMPolicy(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ IAM policy %s deleted successfully", args[0])
 }
 
-func ListIAMPermissionsGroups(_ *cobra.Command, _ []string) {
-	common.ManageListRequestNoExpand("/v2/iam/permissionsGroup", iamPermissionsGroupColumnsToDisplay, flags.GenericFilters)
+func ListIAMPermissionsGroups(cmd *cobra.Command, _ []string) {
+	common.ManageListRequestNoExpand(cmd.Context(), "/v2/iam/permissionsGroup", iamPermissionsGroupColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetIAMPermissionsGroup(_ *cobra.Command, args []string) {
@@ -178,8 +187,8 @@ func EditIAMPermissionsGroup(cmd *cobra.Command, args []string) {
 	}
 }
 
-func ListIAMResources(_ *cobra.Command, _ []string) {
-	common.ManageListRequestNoExpand("/v2/iam/resource", iamResourceColumnsToDisplay, flags.GenericFilters)
+func ListIAMResources(cmd *cobra.Command, _ []string) {
+	common.ManageListRequestNoExpand(cmd.Context(), "/v2/iam/resource", iamResourceColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetIAMResource(_ *cobra.Command, args []string) {
@@ -199,8 +208,8 @@ func EditIAMResource(cmd *cobra.Command, args []string) {
 	}
 }
 
-func ListIAMResourceGroups(_ *cobra.Command, _ []string) {
-	common.ManageListRequestNoExpand("/v2/iam/resourceGroup", iamResourceGroupColumnsToDisplay, flags.GenericFilters)
+func ListIAMResourceGroups(cmd *cobra.Command, _ []string) {
+	common.ManageListRequestNoExpand(cmd.Context(), "/v2/iam/resourceGroup", iamResourceGroupColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetIAMResourceGroup(_ *cobra.Command, args []string) {
@@ -248,8 +257,8 @@ func prepareIAMPermissionsFromCLI() {
 	}
 }
 
-func ListUsers(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/me/identity/user", "", []string{"login", "group", "description"}, flags.GenericFilters)
+func ListUsers(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/me/identity/user", "", []string{"login", "group", "description"}, flags.GenericFilters)
 }
 
 func GetUser(_ *cobra.Command, args []string) {
@@ -296,9 +305,9 @@ func DeleteUser(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ User %s deleted successfully", args[0])
 }
 
-func ListUserTokens(_ *cobra.Command, args []string) {
+func ListUserTokens(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/me/identity/user/%s/token", url.PathEscape(args[0]))
-	common.ManageListRequest(endpoint, "", []string{"name", "description", "expiresAt"}, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), endpoint, "", []string{"name", "description", "expiresAt"}, flags.GenericFilters)
 }
 
 func GetUserToken(_ *cobra.Command, args []string) {
@@ -0,0 +1,331 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/PaesslerAG/gval"
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	filtersLib "github.com/ovh/ovhcloud-cli/internal/filters"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/spf13/cobra"
+)
+
+// Types of the hosts of the inventory, also used as group names
+const (
+	hostTypeCloudInstance = "cloud_instance"
+	hostTypeVps           = "vps"
+	hostTypeBaremetal     = "baremetal"
+)
+
+var (
+	// AnsibleList outputs the whole inventory
+	AnsibleList bool
+
+	// AnsibleHost outputs the variables of the given host
+	AnsibleHost string
+
+	// AnsibleGroupBy are the gval expressions returning the additional groups of each host
+	AnsibleGroupBy []string
+
+	// groupNameRegexp matches the characters not allowed in Ansible group names
+	groupNameRegexp = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+)
+
+// inventoryHost is a host of the inventory
+type inventoryHost struct {
+	name   string
+	groups []string
+
+	// address is the public IP used to connect to the host, if any
+	address string
+
+	// details are the properties of the host, with its type and the project of
+	// the cloud instances
+	details map[string]any
+}
+
+func AnsibleInventory(cmd *cobra.Command, _ []string) {
+	groupBy := make(gval.Evaluables, 0, len(AnsibleGroupBy))
+	for _, expression := range AnsibleGroupBy {
+		ev, err := gval.Full(filtersLib.AdditionalEvaluators...).NewEvaluable(expression)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "invalid group-by expression %q: %s", expression, err)
+			return
+		}
+		groupBy = append(groupBy, ev)
+	}
+
+	hosts, err := fetchInventoryHosts(cmd.Context())
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to fetch inventory: %s", err)
+		return
+	}
+
+	hostvars := make(map[string]any, len(hosts))
+	groups := make(map[string][]any)
+	for _, host := range hosts {
+		matching, err := filtersLib.FilterLines([]map[string]any{host.details}, flags.GenericFilters)
+		if err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to filter hosts: %s", err)
+			return
+		}
+		if len(matching) == 0 {
+			continue
+		}
+
+		vars := map[string]any{"ovhcloud": host.details}
+		if host.address != "" {
+			vars["ansible_host"] = host.address
+		}
+		hostvars[host.name] = vars
+
+		hostGroups := host.groups
+		for _, ev := range groupBy {
+			hostGroups = append(hostGroups, evalHostGroups(ev, host.details)...)
+		}
+
+		for _, group := range hostGroups {
+			group = ansibleGroupName(group)
+			if group != "" && !slices.Contains(groups[group], any(host.name)) {
+				groups[group] = append(groups[group], host.name)
+			}
+		}
+	}
+
+	if !AnsibleList {
+		vars, ok := hostvars[AnsibleHost].(map[string]any)
+		if !ok {
+			vars = map[string]any{}
+		}
+		display.OutputObject(vars, "", "", &flags.OutputFormatConfig)
+		return
+	}
+
+	// Ansible dynamic inventory format, with the variables of all the hosts in
+	// _meta so that Ansible does not call --host for each of them
+	inventory := map[string]any{
+		"_meta": map[string]any{"hostvars": hostvars},
+	}
+
+	children := make([]any, 0, len(groups))
+	for group, groupHosts := range groups {
+		inventory[group] = map[string]any{"hosts": groupHosts}
+		children = append(children, group)
+	}
+	slices.SortFunc(children, func(a, b any) int {
+		return strings.Compare(a.(string), b.(string))
+	})
+	inventory["all"] = map[string]any{"children": children}
+
+	display.OutputObject(inventory, "", "", &flags.OutputFormatConfig)
+}
+
+// fetchInventoryHosts returns the cloud instances of all the projects, the VPS and
+// the dedicated servers of the account
+func fetchInventoryHosts(ctx context.Context) ([]*inventoryHost, error) {
+	instances, err := fetchCloudInstanceHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vps, err := fetchVpsHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := fetchBaremetalHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hosts := slices.Concat(instances, vps, servers)
+
+	// Host names must be unique, instances having the same name in several
+	// projects are suffixed by their ID
+	names := make(map[string]int, len(hosts))
+	for _, host := range hosts {
+		names[host.name]++
+	}
+	for _, host := range hosts {
+		if names[host.name] > 1 {
+			host.name = fmt.Sprintf("%s_%s", host.name, host.details["id"])
+		}
+	}
+
+	return hosts, nil
+}
+
+// fetchCloudInstanceHosts returns the instances of all the cloud projects, grouped by
+// project, region, flavor and image
+func fetchCloudInstanceHosts(ctx context.Context) ([]*inventoryHost, error) {
+	projects, err := httpLib.FetchArray(ctx, "/v1/cloud/project", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch cloud projects: %w", err)
+	}
+
+	var hosts []*inventoryHost
+	for _, projectID := range projects {
+		path := fmt.Sprintf("/v1/cloud/project/%s/instance", url.PathEscape(fmt.Sprint(projectID)))
+
+		instances, err := httpLib.FetchExpandedArray(ctx, path, "id")
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch instances of project %s: %w", projectID, err)
+		}
+
+		for _, instance := range instances {
+			instance["type"] = hostTypeCloudInstance
+			instance["projectId"] = projectID
+
+			host := &inventoryHost{
+				name:    fmt.Sprint(instance["name"]),
+				address: cloudInstancePublicIP(instance),
+				details: instance,
+				groups: []string{
+					hostTypeCloudInstance,
+					"project_" + fmt.Sprint(projectID),
+					"region_" + fmt.Sprint(instance["region"]),
+				},
+			}
+			if flavor, ok := instance["flavor"].(map[string]any); ok {
+				host.groups = append(host.groups, "flavor_"+fmt.Sprint(flavor["name"]))
+			}
+			if image, ok := instance["image"].(map[string]any); ok {
+				host.groups = append(host.groups, "image_"+fmt.Sprint(image["name"]))
+			}
+
+			hosts = append(hosts, host)
+		}
+	}
+
+	return hosts, nil
+}
+
+// cloudInstancePublicIP returns the public IPv4 of the given instance, or its public
+// IPv6 if it has no public IPv4
+func cloudInstancePublicIP(instance map[string]any) string {
+	var address string
+
+	addresses, _ := instance["ipAddresses"].([]any)
+	for _, addr := range addresses {
+		details, ok := addr.(map[string]any)
+		if !ok || details["type"] != "public" {
+			continue
+		}
+
+		ip, _ := details["ip"].(string)
+		if fmt.Sprint(details["version"]) == "4" {
+			return ip
+		}
+		if address == "" {
+			address = ip
+		}
+	}
+
+	return address
+}
+
+func fetchVpsHosts(ctx context.Context) ([]*inventoryHost, error) {
+	servers, err := httpLib.FetchExpandedArray(ctx, "/v1/vps", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch VPS: %w", err)
+	}
+
+	hosts := make([]*inventoryHost, 0, len(servers))
+	for _, vps := range servers {
+		ips, err := httpLib.FetchArray(ctx, fmt.Sprintf("/v1/vps/%s/ips", url.PathEscape(fmt.Sprint(vps["name"]))), "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch IPs of VPS %s: %w", vps["name"], err)
+		}
+
+		vps["type"] = hostTypeVps
+		hosts = append(hosts, &inventoryHost{
+			name:    fmt.Sprint(vps["name"]),
+			address: firstIPv4(ips),
+			details: vps,
+			groups:  []string{hostTypeVps},
+		})
+	}
+
+	return hosts, nil
+}
+
+// firstIPv4 returns the first IPv4 of the given list, or its first IP if it contains no IPv4
+func firstIPv4(ips []any) string {
+	for _, ip := range ips {
+		if ip, ok := ip.(string); ok && !strings.Contains(ip, ":") {
+			return ip
+		}
+	}
+
+	if len(ips) > 0 {
+		return fmt.Sprint(ips[0])
+	}
+
+	return ""
+}
+
+func fetchBaremetalHosts(ctx context.Context) ([]*inventoryHost, error) {
+	servers, err := httpLib.FetchExpandedArray(ctx, "/v1/dedicated/server", "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch dedicated servers: %w", err)
+	}
+
+	hosts := make([]*inventoryHost, 0, len(servers))
+	for _, server := range servers {
+		server["type"] = hostTypeBaremetal
+
+		address, _ := server["ip"].(string)
+		hosts = append(hosts, &inventoryHost{
+			name:    fmt.Sprint(server["name"]),
+			address: address,
+			details: server,
+			groups:  []string{hostTypeBaremetal},
+		})
+	}
+
+	return hosts, nil
+}
+
+// evalHostGroups returns the groups returned by the given expression for a host.
+// Hosts for which the expression fails, for example when it uses properties of
+// another type of host, or returns an empty value are not added to any group.
+func evalHostGroups(ev gval.Evaluable, details map[string]any) []string {
+	value, err := ev(context.Background(), details)
+	if err != nil {
+		return nil
+	}
+
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+
+	var groups []string
+	for _, value := range values {
+		switch value := value.(type) {
+		case string:
+			groups = append(groups, value)
+		case json.Number, float64, int:
+			groups = append(groups, fmt.Sprint(value))
+		}
+	}
+
+	return groups
+}
+
+// ansibleGroupName returns the given name with the characters not allowed in
+// Ansible group names replaced
+func ansibleGroupName(name string) string {
+	return strings.Trim(groupNameRegexp.ReplaceAllString(name, "_"), "_")
+}
@@ -28,8 +28,14 @@ var (
 	}
 )
 
-func ListIp(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/ip", "", ipColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("ip", map[string]*string{
+		"ip": &ipTemplate,
+	})
+}
+
+func ListIp(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/ip", "", ipColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetIp(_ *cobra.Command, args []string) {
@@ -62,9 +68,9 @@ func IpSetReverse(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "⚡️ Reverse correctly set")
 }
 
-func IpGetReverse(_ *cobra.Command, args []string) {
+func IpGetReverse(cmd *cobra.Command, args []string) {
 	url := fmt.Sprintf("/v1/ip/%s/reverse", url.PathEscape(args[0]))
-	common.ManageListRequest(url, "", []string{"ipReverse", "reverse"}, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), url, "", []string{"ipReverse", "reverse"}, flags.GenericFilters)
 }
 
 func IpDeleteReverse(_ *cobra.Command, args []string) {
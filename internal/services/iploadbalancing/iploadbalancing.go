@@ -28,8 +28,14 @@ var (
 	}
 )
 
-func ListIpLoadbalancing(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/ipLoadbalancing", "", iploadbalancingColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("iploadbalancing", map[string]*string{
+		"iploadbalancing": &iploadbalancingTemplate,
+	})
+}
+
+func ListIpLoadbalancing(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/ipLoadbalancing", "", iploadbalancingColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetIpLoadbalancing(_ *cobra.Command, args []string) {
@@ -28,8 +28,14 @@ var (
 	}
 )
 
-func ListLdp(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/dbaas/logs", "", ldpColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("ldp", map[string]*string{
+		"ldp": &ldpTemplate,
+	})
+}
+
+func ListLdp(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/dbaas/logs", "", ldpColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetLdp(_ *cobra.Command, args []string) {
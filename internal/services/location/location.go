@@ -7,6 +7,7 @@ package location
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
@@ -19,8 +20,14 @@ var (
 	locationTemplate string
 )
 
-func ListLocation(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v2/location", "name", locationColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("location", map[string]*string{
+		"location": &locationTemplate,
+	})
+}
+
+func ListLocation(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v2/location", "name", locationColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetLocation(_ *cobra.Command, args []string) {
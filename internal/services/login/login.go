@@ -5,9 +5,6 @@
 package login
 
 import (
-	"fmt"
-	"strings"
-
 	"github.com/ovh/ovhcloud-cli/internal/config"
 	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
@@ -15,6 +12,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// defaultProfile is the profile in which credentials are saved when no profile is selected
+const defaultProfile = "default"
+
 func Login(_ *cobra.Command, _ []string) {
 	selectedRegion := display.RunLoginPicker("Which OVHcloud API do you want to login to ?", []string{"EU", "CA", "US", "Custom endpoint"})
 
@@ -32,41 +32,55 @@ func Login(_ *cobra.Command, _ []string) {
 	}
 
 	// If no configuration file could be loaded, choose the location to write a new one
-	if flags.CliConfigPath == "" {
-		choices := make(map[string]string, len(config.ConfigPaths))
-		expandedPaths := config.ExpandConfigPaths()
-		for idx, cfg := range config.ConfigPaths {
-			choices[cfg] = expandedPaths[idx]
+	if flags.CliConfigPath == "" && flags.ConfigScope == "" {
+		choices := make(map[string]string, len(config.ConfigScopes))
+		for idx, scope := range config.ConfigScopes {
+			choices[scope] = config.ConfigPaths[idx]
 		}
 
-		_, path, err := display.RunGenericChoicePicker("Please choose a location to store your configuration", choices, 0)
+		scope, _, err := display.RunGenericChoicePicker("Please choose a location to store your configuration", choices, 0)
 		if err != nil {
 			display.OutputError(&flags.OutputFormatConfig, "failed to select a config path: %s", err)
 			return
 		}
 
-		if path == "" {
+		if scope == "" {
 			display.OutputWarning(&flags.OutputFormatConfig, "no config path selected, configuration not saved")
 			return
 		}
 
-		flags.CliConfigPath = path
+		flags.ConfigScope = scope
 	}
 
-	// Set API endpoint to use in config
-	configSection := fmt.Sprintf("ovh-%s", strings.ToLower(selectedRegion))
+	// Set API endpoint to use in profile
 	if customEndpoint {
 		selectedRegion = credentials["endpoint"]
-		delete(credentials, "endpoint")
-		configSection = selectedRegion
 	}
-	serviceconfig.SetEndpoint(nil, []string{selectedRegion})
+	endpoint, err := serviceconfig.ParseEndpoint(selectedRegion)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "%s", err)
+		return
+	}
+	credentials["endpoint"] = endpoint
 
-	// Set credentials in config
-	for k, v := range credentials {
-		if err := config.SetConfigValue(flags.CliConfig, flags.CliConfigPath, configSection, k, v); err != nil {
-			display.OutputError(&flags.OutputFormatConfig, "failed to write configuration %q: %s", k, err)
+	// Write endpoint and credentials in the selected profile,
+	// or in the "default" one if no profile is in use
+	profile := config.ResolveProfile(flags.CliConfig, flags.Profile)
+	if profile == "" {
+		profile = defaultProfile
+	}
+	if err := config.SetProfile(flags.CliConfig, flags.ConfigScope, profile, credentials); err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to write configuration: %s", err)
+		return
+	}
+
+	// Use the profile by default if no other one is configured
+	if activeProfile, _ := config.GetConfigValue(flags.CliConfig, "", "profile"); activeProfile == "" {
+		if err := config.SetConfigValue(flags.CliConfig, flags.ConfigScope, "", "profile", profile); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to set active profile: %s", err)
 			return
 		}
 	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Credentials saved in profile %s", profile)
 }
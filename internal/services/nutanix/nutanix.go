@@ -7,6 +7,7 @@ package nutanix
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
@@ -19,8 +20,14 @@ var (
 	nutanixTemplate string
 )
 
-func ListNutanix(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/nutanix", "", nutanixColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("nutanix", map[string]*string{
+		"nutanix": &nutanixTemplate,
+	})
+}
+
+func ListNutanix(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/nutanix", "", nutanixColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetNutanix(_ *cobra.Command, args []string) {
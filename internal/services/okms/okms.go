@@ -7,6 +7,7 @@ package okms
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
@@ -19,8 +20,14 @@ var (
 	okmsTemplate string
 )
 
-func ListOkms(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v2/okms/resource", "id", okmsColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("okms", map[string]*string{
+		"okms": &okmsTemplate,
+	})
+}
+
+func ListOkms(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v2/okms/resource", "id", okmsColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetOkms(_ *cobra.Command, args []string) {
@@ -29,8 +29,14 @@ var (
 	}
 )
 
-func ListOverTheBox(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/overTheBox", "", overtheboxColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("overthebox", map[string]*string{
+		"overthebox": &overtheboxTemplate,
+	})
+}
+
+func ListOverTheBox(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/overTheBox", "", overtheboxColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetOverTheBox(_ *cobra.Command, args []string) {
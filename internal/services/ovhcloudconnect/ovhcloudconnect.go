@@ -27,8 +27,14 @@ var (
 	}
 )
 
-func ListOvhCloudConnect(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/ovhCloudConnect", "", ovhcloudconnectColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("ovhcloudconnect", map[string]*string{
+		"ovhcloudconnect": &ovhcloudconnectTemplate,
+	})
+}
+
+func ListOvhCloudConnect(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/ovhCloudConnect", "", ovhcloudconnectColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetOvhCloudConnect(_ *cobra.Command, args []string) {
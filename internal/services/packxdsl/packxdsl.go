@@ -27,8 +27,14 @@ var (
 	}
 )
 
-func ListPackXDSL(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/pack/xdsl", "", packxdslColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("packxdsl", map[string]*string{
+		"packxdsl": &packxdslTemplate,
+	})
+}
+
+func ListPackXDSL(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/pack/xdsl", "", packxdslColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetPackXDSL(_ *cobra.Command, args []string) {
@@ -45,8 +45,14 @@ var (
 	}
 )
 
-func ListSms(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/sms", "", smsColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("sms", map[string]*string{
+		"sms": &smsTemplate,
+	})
+}
+
+func ListSms(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/sms", "", smsColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetSms(_ *cobra.Command, args []string) {
@@ -7,6 +7,7 @@ package ssl
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
@@ -19,8 +20,14 @@ var (
 	sslTemplate string
 )
 
-func ListSsl(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/ssl", "", sslColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("ssl", map[string]*string{
+		"ssl": &sslTemplate,
+	})
+}
+
+func ListSsl(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/ssl", "", sslColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetSsl(_ *cobra.Command, args []string) {
@@ -33,8 +33,14 @@ var (
 	}
 )
 
-func ListSslGateway(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/sslGateway", "", sslgatewayColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("sslgateway", map[string]*string{
+		"sslgateway": &sslgatewayTemplate,
+	})
+}
+
+func ListSslGateway(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/sslGateway", "", sslgatewayColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetSslGateway(_ *cobra.Command, args []string) {
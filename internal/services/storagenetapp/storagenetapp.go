@@ -27,8 +27,14 @@ var (
 	}
 )
 
-func ListStorageNetApp(_ *cobra.Command, _ []string) {
-	common.ManageListRequestNoExpand("/v1/storage/netapp", storagenetappColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("storagenetapp", map[string]*string{
+		"storagenetapp": &storagenetappTemplate,
+	})
+}
+
+func ListStorageNetApp(cmd *cobra.Command, _ []string) {
+	common.ManageListRequestNoExpand(cmd.Context(), "/v1/storage/netapp", storagenetappColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetStorageNetApp(_ *cobra.Command, args []string) {
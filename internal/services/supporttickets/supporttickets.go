@@ -7,6 +7,7 @@ package supporttickets
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
@@ -19,8 +20,14 @@ var (
 	supportticketsTemplate string
 )
 
-func ListSupportTickets(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/support/tickets", "", supportticketsColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("supporttickets", map[string]*string{
+		"supporttickets": &supportticketsTemplate,
+	})
+}
+
+func ListSupportTickets(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/support/tickets", "", supportticketsColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetSupportTickets(_ *cobra.Command, args []string) {
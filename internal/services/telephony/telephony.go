@@ -34,8 +34,14 @@ var (
 	}
 )
 
-func ListTelephony(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/telephony", "", telephonyColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("telephony", map[string]*string{
+		"telephony": &telephonyTemplate,
+	})
+}
+
+func ListTelephony(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/telephony", "", telephonyColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetTelephony(_ *cobra.Command, args []string) {
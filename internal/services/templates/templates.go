@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package templates
+
+import (
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	"github.com/spf13/cobra"
+)
+
+// ForceExport allows to overwrite the existing files when exporting templates
+var ForceExport bool
+
+func ExportTemplates(_ *cobra.Command, args []string) {
+	files, err := display.ExportTemplates(args[0], ForceExport)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to export templates: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, files, "✅ %d templates exported to %s", len(files), args[0])
+}
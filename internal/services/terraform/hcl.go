@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraform
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// hclAttribute is an attribute of a resource definition
+type hclAttribute struct {
+	name  string
+	value any
+}
+
+// hclBlock is a nested block of a resource definition, repeated for each of
+// the values when the attribute value is a list of blocks
+type hclBlock []hclAttribute
+
+// hclReference is an expression referencing an attribute of another resource
+type hclReference string
+
+// renderImportBlocks writes the import block and the skeleton definition of each of
+// the given resources
+func renderImportBlocks(w io.Writer, resources []*importedResource) {
+	for i, resource := range resources {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		address := resource.Type + "." + resource.Name
+		fmt.Fprintf(w, "import {\n  to = %s\n  id = %s\n}\n\n", address, hclString(resource.ID))
+
+		fmt.Fprintf(w, "resource %s %s {\n", hclString(resource.Type), hclString(resource.Name))
+		renderAttributes(w, resource.Attributes, "  ")
+		fmt.Fprintln(w, "}")
+	}
+}
+
+// renderAttributes writes the given attributes with their equal signs aligned, as
+// done by terraform fmt, followed by the nested blocks
+func renderAttributes(w io.Writer, attributes []hclAttribute, indent string) {
+	var (
+		width  int
+		blocks []hclAttribute
+	)
+	for _, attr := range attributes {
+		if _, ok := attr.value.([]hclBlock); ok {
+			blocks = append(blocks, attr)
+			continue
+		}
+		width = max(width, len(attr.name))
+	}
+
+	for _, attr := range attributes {
+		if _, ok := attr.value.([]hclBlock); ok {
+			continue
+		}
+		fmt.Fprintf(w, "%s%-*s = %s\n", indent, width, attr.name, hclValue(attr.value))
+	}
+
+	for _, attr := range blocks {
+		for _, block := range attr.value.([]hclBlock) {
+			fmt.Fprintf(w, "\n%s%s {\n", indent, attr.name)
+			renderAttributes(w, block, indent+"  ")
+			fmt.Fprintf(w, "%s}\n", indent)
+		}
+	}
+}
+
+// hclValue returns the HCL expression of the given value
+func hclValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case hclReference:
+		return string(value)
+	case string:
+		return hclString(value)
+	case []any:
+		items := make([]string, 0, len(value))
+		for _, item := range value {
+			items = append(items, hclValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// hclString returns the given string as a quoted HCL string, with the
+// template sequences escaped
+func hclString(value string) string {
+	var buf bytes.Buffer
+
+	// JSON escape sequences are valid in HCL strings
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.Encode(value) //nolint:errcheck
+
+	quoted := strings.TrimSuffix(buf.String(), "\n")
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+// attributesObject returns the given attributes as an object
+func attributesObject(attributes []hclAttribute) map[string]any {
+	object := make(map[string]any, len(attributes))
+	for _, attr := range attributes {
+		switch value := attr.value.(type) {
+		case []hclBlock:
+			blocks := make([]any, 0, len(value))
+			for _, block := range value {
+				blocks = append(blocks, attributesObject(block))
+			}
+			object[attr.name] = blocks
+		case hclReference:
+			object[attr.name] = "${" + string(value) + "}"
+		default:
+			object[attr.name] = value
+		}
+	}
+	return object
+}
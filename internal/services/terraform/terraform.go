@@ -0,0 +1,550 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/ovh/ovhcloud-cli/internal/display"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/services/cloud"
+	"github.com/spf13/cobra"
+)
+
+// Types of the resources of the OVH Terraform provider that can be imported
+const (
+	typeNetwork      = "ovh_cloud_project_network_private"
+	typeSubnet       = "ovh_cloud_project_network_private_subnet"
+	typeKube         = "ovh_cloud_project_kube"
+	typeKubeNodepool = "ovh_cloud_project_kube_nodepool"
+	typeDatabase     = "ovh_cloud_project_database"
+	typeStorage      = "ovh_cloud_project_storage"
+	typeCloudUser    = "ovh_cloud_project_user"
+	typeZoneRecord   = "ovh_domain_zone_record"
+	typeIPReverse    = "ovh_ip_reverse"
+	typeIAMPolicy    = "ovh_iam_policy"
+)
+
+// cloudTypesPrefix is the prefix of the types of the cloud project resources
+const cloudTypesPrefix = "ovh_cloud_project_"
+
+var (
+	// ResourceTypes are the supported resource types, in generation order
+	ResourceTypes = []string{
+		typeNetwork, typeSubnet, typeKube, typeKubeNodepool, typeDatabase, typeStorage,
+		typeCloudUser, typeZoneRecord, typeIPReverse, typeIAMPolicy,
+	}
+
+	// ImportTypes are the resource types to generate, all of them if empty
+	ImportTypes []string
+
+	// ImportZones are the domain zones whose records are generated, all of them if empty
+	ImportZones []string
+
+	// labelRegexp matches the characters not allowed in resource names
+	labelRegexp = regexp.MustCompile(`[^a-z0-9_-]+`)
+)
+
+// importedResource is a resource to import in the Terraform state
+type importedResource struct {
+	Type       string         `json:"type"`
+	Name       string         `json:"name"`
+	ID         string         `json:"id"`
+	Attributes []hclAttribute `json:"-"`
+}
+
+// importGenerator enumerates the resources to import
+type importGenerator struct {
+	projectID string
+	types     []string
+	resources []*importedResource
+
+	// labels are the resource names already used, by type
+	labels map[string]map[string]bool
+
+	// addresses are the addresses of the generated resources by ID,
+	// used to reference them from their children
+	addresses map[string]string
+}
+
+func GenerateImportBlocks(cmd *cobra.Command, _ []string) {
+	for _, resourceType := range ImportTypes {
+		if !slices.Contains(ResourceTypes, resourceType) {
+			display.OutputError(&flags.OutputFormatConfig, "unsupported resource type %q, supported types are: %s",
+				resourceType, strings.Join(ResourceTypes, ", "))
+			return
+		}
+	}
+
+	generator := &importGenerator{
+		types:     ImportTypes,
+		labels:    make(map[string]map[string]bool),
+		addresses: make(map[string]string),
+	}
+	if len(generator.types) == 0 {
+		generator.types = ResourceTypes
+	}
+
+	// Cloud resources are generated for the configured cloud project, and are skipped
+	// when no project is configured unless they were explicitly requested
+	if slices.ContainsFunc(generator.types, isCloudType) {
+		projectID, err := cloud.GetConfiguredCloudProject()
+		switch {
+		case err == nil:
+			generator.projectID = projectID
+		case len(ImportTypes) > 0:
+			display.OutputError(&flags.OutputFormatConfig, "%s", err)
+			return
+		default:
+			generator.types = slices.DeleteFunc(slices.Clone(generator.types), isCloudType)
+		}
+	}
+
+	for _, generate := range []func(context.Context) error{
+		generator.generateNetworks,
+		generator.generateKubeClusters,
+		generator.generateDatabases,
+		generator.generateStorages,
+		generator.generateCloudUsers,
+		generator.generateZoneRecords,
+		generator.generateIPReverses,
+		generator.generateIAMPolicies,
+	} {
+		if err := generate(cmd.Context()); err != nil {
+			display.OutputError(&flags.OutputFormatConfig, "failed to enumerate resources: %s", err)
+			return
+		}
+	}
+
+	if flags.OutputFormatConfig.Output != "" {
+		resources := make([]map[string]any, 0, len(generator.resources))
+		for _, resource := range generator.resources {
+			resources = append(resources, map[string]any{
+				"type":       resource.Type,
+				"name":       resource.Name,
+				"id":         resource.ID,
+				"attributes": attributesObject(resource.Attributes),
+			})
+		}
+		display.OutputObject(map[string]any{"resources": resources}, "", "", &flags.OutputFormatConfig)
+		return
+	}
+
+	if len(generator.resources) == 0 {
+		display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ No resources to import")
+		return
+	}
+
+	renderImportBlocks(os.Stdout, generator.resources)
+}
+
+func isCloudType(resourceType string) bool {
+	return strings.HasPrefix(resourceType, cloudTypesPrefix)
+}
+
+func (g *importGenerator) selected(resourceTypes ...string) bool {
+	for _, resourceType := range resourceTypes {
+		if slices.Contains(g.types, resourceType) {
+			return true
+		}
+	}
+	return false
+}
+
+// add adds a resource named after the given name, and returns its address
+func (g *importGenerator) add(resourceType, name, id string, attributes ...hclAttribute) string {
+	label := labelRegexp.ReplaceAllString(strings.ToLower(name), "_")
+	label = strings.Trim(label, "_")
+	if label == "" || !(label[0] == '_' || (label[0] >= 'a' && label[0] <= 'z')) {
+		label = "r_" + label
+	}
+
+	// Resource names must be unique by type
+	if g.labels[resourceType] == nil {
+		g.labels[resourceType] = make(map[string]bool)
+	}
+	unique := label
+	for i := 2; g.labels[resourceType][unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	g.labels[resourceType][unique] = true
+
+	// Attributes without value are left out of the skeleton
+	attributes = slices.DeleteFunc(attributes, func(attr hclAttribute) bool {
+		return attr.value == nil
+	})
+
+	g.resources = append(g.resources, &importedResource{
+		Type:       resourceType,
+		Name:       unique,
+		ID:         id,
+		Attributes: attributes,
+	})
+
+	return resourceType + "." + unique
+}
+
+// reference returns a reference to the ID of the resource generated for the given ID,
+// or the ID itself if the resource was not generated
+func (g *importGenerator) reference(id string) any {
+	if address, ok := g.addresses[id]; ok {
+		return hclReference(address + ".id")
+	}
+	return id
+}
+
+func (g *importGenerator) projectEndpoint(format string, args ...any) string {
+	return fmt.Sprintf("/v1/cloud/project/%s", g.projectID) + fmt.Sprintf(format, args...)
+}
+
+func (g *importGenerator) generateNetworks(ctx context.Context) error {
+	if !g.selected(typeNetwork, typeSubnet) {
+		return nil
+	}
+
+	var networks []map[string]any
+	if err := httpLib.Client.GetWithContext(ctx, g.projectEndpoint("/network/private"), &networks); err != nil {
+		return fmt.Errorf("failed to fetch private networks: %w", err)
+	}
+
+	for _, network := range networks {
+		networkID := fmt.Sprint(network["id"])
+
+		if g.selected(typeNetwork) {
+			regions, _ := network["regions"].([]any)
+			regionNames := make([]any, 0, len(regions))
+			for _, region := range regions {
+				if region, ok := region.(map[string]any); ok {
+					regionNames = append(regionNames, region["region"])
+				}
+			}
+
+			g.addresses[networkID] = g.add(typeNetwork, fmt.Sprint(network["name"]), g.projectID+"/"+networkID,
+				hclAttribute{"service_name", g.projectID},
+				hclAttribute{"name", network["name"]},
+				hclAttribute{"regions", regionNames},
+				hclAttribute{"vlan_id", network["vlanId"]},
+			)
+		}
+
+		if !g.selected(typeSubnet) {
+			continue
+		}
+
+		var subnets []map[string]any
+		if err := httpLib.Client.GetWithContext(ctx, g.projectEndpoint("/network/private/%s/subnet", networkID), &subnets); err != nil {
+			return fmt.Errorf("failed to fetch subnets of network %s: %w", networkID, err)
+		}
+
+		for _, subnet := range subnets {
+			attributes := []hclAttribute{
+				{"service_name", g.projectID},
+				{"network_id", g.reference(networkID)},
+				{"network", subnet["cidr"]},
+				{"no_gateway", subnet["gatewayIp"] == nil},
+			}
+			if pools, _ := subnet["ipPools"].([]any); len(pools) > 0 {
+				if pool, ok := pools[0].(map[string]any); ok {
+					attributes = append(attributes,
+						hclAttribute{"region", pool["region"]},
+						hclAttribute{"start", pool["start"]},
+						hclAttribute{"end", pool["end"]},
+						hclAttribute{"dhcp", pool["dhcp"]},
+					)
+				}
+			}
+
+			g.add(typeSubnet, fmt.Sprintf("%s_%s", network["name"], subnet["cidr"]),
+				fmt.Sprintf("%s/%s/%s", g.projectID, networkID, subnet["id"]), attributes...)
+		}
+	}
+
+	return nil
+}
+
+func (g *importGenerator) generateKubeClusters(ctx context.Context) error {
+	if !g.selected(typeKube, typeKubeNodepool) {
+		return nil
+	}
+
+	clusters, err := httpLib.FetchExpandedArray(ctx, g.projectEndpoint("/kube"), "")
+	if err != nil {
+		return fmt.Errorf("failed to fetch Kubernetes clusters: %w", err)
+	}
+
+	for _, cluster := range clusters {
+		clusterID := fmt.Sprint(cluster["id"])
+
+		if g.selected(typeKube) {
+			g.addresses[clusterID] = g.add(typeKube, fmt.Sprint(cluster["name"]), g.projectID+"/"+clusterID,
+				hclAttribute{"service_name", g.projectID},
+				hclAttribute{"name", cluster["name"]},
+				hclAttribute{"region", cluster["region"]},
+				hclAttribute{"version", cluster["version"]},
+			)
+		}
+
+		if !g.selected(typeKubeNodepool) {
+			continue
+		}
+
+		var nodepools []map[string]any
+		if err := httpLib.Client.GetWithContext(ctx, g.projectEndpoint("/kube/%s/nodepool", clusterID), &nodepools); err != nil {
+			return fmt.Errorf("failed to fetch node pools of cluster %s: %w", clusterID, err)
+		}
+
+		for _, nodepool := range nodepools {
+			g.add(typeKubeNodepool, fmt.Sprintf("%s_%s", cluster["name"], nodepool["name"]),
+				fmt.Sprintf("%s/%s/%s", g.projectID, clusterID, nodepool["id"]),
+				hclAttribute{"service_name", g.projectID},
+				hclAttribute{"kube_id", g.reference(clusterID)},
+				hclAttribute{"name", nodepool["name"]},
+				hclAttribute{"flavor_name", nodepool["flavor"]},
+				hclAttribute{"desired_nodes", nodepool["desiredNodes"]},
+				hclAttribute{"min_nodes", nodepool["minNodes"]},
+				hclAttribute{"max_nodes", nodepool["maxNodes"]},
+				hclAttribute{"autoscale", nodepool["autoscale"]},
+				hclAttribute{"monthly_billed", nodepool["monthlyBilled"]},
+				hclAttribute{"anti_affinity", nodepool["antiAffinity"]},
+			)
+		}
+	}
+
+	return nil
+}
+
+func (g *importGenerator) generateDatabases(ctx context.Context) error {
+	if !g.selected(typeDatabase) {
+		return nil
+	}
+
+	databases, err := httpLib.FetchExpandedArray(ctx, g.projectEndpoint("/database/service"), "")
+	if err != nil {
+		return fmt.Errorf("failed to fetch database services: %w", err)
+	}
+
+	for _, database := range databases {
+		nodes, _ := database["nodes"].([]any)
+		nodeBlocks := make([]hclBlock, 0, len(nodes))
+		for _, node := range nodes {
+			if node, ok := node.(map[string]any); ok {
+				nodeBlocks = append(nodeBlocks, hclBlock{{"region", node["region"]}})
+			}
+		}
+
+		g.add(typeDatabase, labelSource(database["description"], database["id"]),
+			fmt.Sprintf("%s/%s/%s", g.projectID, database["engine"], database["id"]),
+			hclAttribute{"service_name", g.projectID},
+			hclAttribute{"description", database["description"]},
+			hclAttribute{"engine", database["engine"]},
+			hclAttribute{"version", database["version"]},
+			hclAttribute{"plan", database["plan"]},
+			hclAttribute{"flavor", database["flavor"]},
+			hclAttribute{"nodes", nodeBlocks},
+		)
+	}
+
+	return nil
+}
+
+func (g *importGenerator) generateStorages(ctx context.Context) error {
+	if !g.selected(typeStorage) {
+		return nil
+	}
+
+	regions, err := cloud.GetCloudRegionsWithFeatureAvailable(ctx, g.projectID, "storage-s3-high-perf", "storage-s3-standard")
+	if err != nil {
+		return fmt.Errorf("failed to fetch regions with storage feature available: %w", err)
+	}
+
+	containers, err := httpLib.FetchObjectsParallel[[]map[string]any](ctx, g.projectEndpoint("/region/%%s/storage"), regions, true)
+	if err != nil {
+		return fmt.Errorf("failed to fetch storage containers: %w", err)
+	}
+
+	for _, regionContainers := range containers {
+		for _, container := range regionContainers {
+			g.add(typeStorage, fmt.Sprint(container["name"]),
+				fmt.Sprintf("%s/%s/%s", g.projectID, container["region"], container["name"]),
+				hclAttribute{"service_name", g.projectID},
+				hclAttribute{"region_name", container["region"]},
+				hclAttribute{"name", container["name"]},
+			)
+		}
+	}
+
+	return nil
+}
+
+func (g *importGenerator) generateCloudUsers(ctx context.Context) error {
+	if !g.selected(typeCloudUser) {
+		return nil
+	}
+
+	var users []map[string]any
+	if err := httpLib.Client.GetWithContext(ctx, g.projectEndpoint("/user"), &users); err != nil {
+		return fmt.Errorf("failed to fetch cloud users: %w", err)
+	}
+
+	for _, user := range users {
+		roles, _ := user["roles"].([]any)
+		roleNames := make([]any, 0, len(roles))
+		for _, role := range roles {
+			if role, ok := role.(map[string]any); ok {
+				roleNames = append(roleNames, role["name"])
+			}
+		}
+
+		g.add(typeCloudUser, labelSource(user["description"], user["username"]), fmt.Sprintf("%s/%s", g.projectID, user["id"]),
+			hclAttribute{"service_name", g.projectID},
+			hclAttribute{"description", user["description"]},
+			hclAttribute{"role_names", roleNames},
+		)
+	}
+
+	return nil
+}
+
+func (g *importGenerator) generateZoneRecords(ctx context.Context) error {
+	if !g.selected(typeZoneRecord) {
+		return nil
+	}
+
+	zones := make([]any, 0, len(ImportZones))
+	for _, zone := range ImportZones {
+		zones = append(zones, zone)
+	}
+	if len(zones) == 0 {
+		var err error
+		if zones, err = httpLib.FetchArray(ctx, "/v1/domain/zone", ""); err != nil {
+			return fmt.Errorf("failed to fetch domain zones: %w", err)
+		}
+	}
+
+	for _, zone := range zones {
+		records, err := httpLib.FetchExpandedArray(ctx, fmt.Sprintf("/v1/domain/zone/%s/record", url.PathEscape(fmt.Sprint(zone))), "")
+		if err != nil {
+			return fmt.Errorf("failed to fetch records of zone %s: %w", zone, err)
+		}
+
+		for _, record := range records {
+			g.add(typeZoneRecord, fmt.Sprintf("%s_%s_%s", zone, record["subDomain"], record["fieldType"]),
+				fmt.Sprintf("%s.%s", record["id"], zone),
+				hclAttribute{"zone", record["zone"]},
+				hclAttribute{"subdomain", record["subDomain"]},
+				hclAttribute{"fieldtype", record["fieldType"]},
+				hclAttribute{"ttl", record["ttl"]},
+				hclAttribute{"target", record["target"]},
+			)
+		}
+	}
+
+	return nil
+}
+
+func (g *importGenerator) generateIPReverses(ctx context.Context) error {
+	if !g.selected(typeIPReverse) {
+		return nil
+	}
+
+	ips, err := httpLib.FetchArray(ctx, "/v1/ip", "")
+	if err != nil {
+		return fmt.Errorf("failed to fetch IPs: %w", err)
+	}
+
+	for _, ip := range ips {
+		path := fmt.Sprintf("/v1/ip/%s/reverse", url.PathEscape(fmt.Sprint(ip)))
+
+		ipReverses, err := httpLib.FetchArray(ctx, path, "")
+		if err != nil {
+			return fmt.Errorf("failed to fetch reverses of IP %s: %w", ip, err)
+		}
+
+		// The escaped IP blocks contain "%", which must not be interpreted
+		// in the path format of the reverses
+		reverses, err := httpLib.FetchObjectsParallel[map[string]any](ctx, strings.ReplaceAll(path, "%", "%%")+"/%s", ipReverses, false)
+		if err != nil {
+			return fmt.Errorf("failed to fetch reverses of IP %s: %w", ip, err)
+		}
+
+		for _, reverse := range reverses {
+			g.add(typeIPReverse, fmt.Sprint(reverse["ipReverse"]), fmt.Sprintf("%s|%s", ip, reverse["ipReverse"]),
+				hclAttribute{"ip", ip},
+				hclAttribute{"ip_reverse", reverse["ipReverse"]},
+				hclAttribute{"reverse", reverse["reverse"]},
+			)
+		}
+	}
+
+	return nil
+}
+
+func (g *importGenerator) generateIAMPolicies(ctx context.Context) error {
+	if !g.selected(typeIAMPolicy) {
+		return nil
+	}
+
+	var policies []map[string]any
+	if err := httpLib.Client.GetWithContext(ctx, "/v2/iam/policy", &policies); err != nil {
+		return fmt.Errorf("failed to fetch IAM policies: %w", err)
+	}
+
+	for _, policy := range policies {
+		// Policies managed by OVHcloud cannot be managed by Terraform
+		if readOnly, _ := policy["readOnly"].(bool); readOnly {
+			continue
+		}
+
+		permissions, _ := policy["permissions"].(map[string]any)
+		g.add(typeIAMPolicy, fmt.Sprint(policy["name"]), fmt.Sprint(policy["id"]),
+			hclAttribute{"name", policy["name"]},
+			hclAttribute{"description", policy["description"]},
+			hclAttribute{"identities", policy["identities"]},
+			hclAttribute{"resources", fieldValues(policy["resources"], "urn")},
+			hclAttribute{"allow", fieldValues(permissions["allow"], "action")},
+			hclAttribute{"except", fieldValues(permissions["except"], "action")},
+			hclAttribute{"deny", fieldValues(permissions["deny"], "action")},
+			hclAttribute{"permissions_groups", fieldValues(policy["permissionsGroups"], "urn")},
+		)
+	}
+
+	return nil
+}
+
+// labelSource returns the first of the given values that is a non-empty string,
+// used to name a resource
+func labelSource(values ...any) string {
+	for _, value := range values {
+		if value, ok := value.(string); ok && value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// fieldValues returns the values of the given field of a list of objects, or
+// nil if the list is empty
+func fieldValues(objects any, field string) any {
+	list, _ := objects.([]any)
+	if len(list) == 0 {
+		return nil
+	}
+
+	values := make([]any, 0, len(list))
+	for _, object := range list {
+		if object, ok := object.(map[string]any); ok {
+			values = append(values, object[field])
+		}
+	}
+
+	return values
+}
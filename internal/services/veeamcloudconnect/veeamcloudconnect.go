@@ -7,6 +7,7 @@ package veeamcloudconnect
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
@@ -19,8 +20,14 @@ var (
 	veeamcloudconnectTemplate string
 )
 
-func ListVeeamCloudConnect(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/veeamCloudConnect", "", veeamcloudconnectColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("veeamcloudconnect", map[string]*string{
+		"veeamcloudconnect": &veeamcloudconnectTemplate,
+	})
+}
+
+func ListVeeamCloudConnect(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/veeamCloudConnect", "", veeamcloudconnectColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetVeeamCloudConnect(_ *cobra.Command, args []string) {
@@ -7,6 +7,7 @@ package veeamenterprise
 import (
 	_ "embed"
 
+	"github.com/ovh/ovhcloud-cli/internal/display"
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
 	"github.com/spf13/cobra"
@@ -19,8 +20,14 @@ var (
 	veeamenterpriseTemplate string
 )
 
-func ListVeeamEnterprise(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/veeam/veeamEnterprise", "", veeamenterpriseColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("veeamenterprise", map[string]*string{
+		"veeamenterprise": &veeamenterpriseTemplate,
+	})
+}
+
+func ListVeeamEnterprise(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/veeam/veeamEnterprise", "", veeamenterpriseColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetVeeamEnterprise(_ *cobra.Command, args []string) {
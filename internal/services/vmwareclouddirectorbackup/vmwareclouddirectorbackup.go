@@ -32,13 +32,19 @@ var (
 	}
 )
 
+func init() {
+	display.RegisterTemplates("vmwareclouddirectorbackup", map[string]*string{
+		"vmwareclouddirectorbackup": &vmwareclouddirectorbackupTemplate,
+	})
+}
+
 type VmwareCloudDirectorBackupOffer struct {
 	Name      string `json:"name,omitempty"`
 	QuotaInTB int    `json:"quotaInTB,omitempty"`
 }
 
-func ListVmwareCloudDirectorBackup(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v2/vmwareCloudDirector/backup", "id", vmwareclouddirectorbackupColumnsToDisplay, flags.GenericFilters)
+func ListVmwareCloudDirectorBackup(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v2/vmwareCloudDirector/backup", "id", vmwareclouddirectorbackupColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetVmwareCloudDirectorBackup(_ *cobra.Command, args []string) {
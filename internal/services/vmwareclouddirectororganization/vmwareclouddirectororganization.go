@@ -30,8 +30,14 @@ var (
 	}
 )
 
-func ListVmwareCloudDirectorOrganization(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v2/vmwareCloudDirector/organization", "id", vmwareclouddirectororganizationColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("vmwareclouddirectororganization", map[string]*string{
+		"vmwareclouddirectororganization": &vmwareclouddirectororganizationTemplate,
+	})
+}
+
+func ListVmwareCloudDirectorOrganization(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v2/vmwareCloudDirector/organization", "id", vmwareclouddirectororganizationColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetVmwareCloudDirectorOrganization(_ *cobra.Command, args []string) {
@@ -9,15 +9,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"net/url"
 	"time"
 
 	"github.com/ovh/ovhcloud-cli/internal/display"
 	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+	"github.com/ovh/ovhcloud-cli/internal/wait"
 )
 
-func waitForVpsTask(serviceName string, taskInput map[string]any, retryDuration time.Duration) (map[string]any, error) {
+func waitForVpsTask(ctx context.Context, serviceName string, taskInput map[string]any, timeout time.Duration) (*wait.Result, error) {
 	id, ok := taskInput["id"]
 	if !ok {
 		return nil, errors.New("task input does not contain 'id'")
@@ -30,39 +30,21 @@ func waitForVpsTask(serviceName string, taskInput map[string]any, retryDuration
 
 	endpoint := fmt.Sprintf("/v1/vps/%s/tasks/%s", url.PathEscape(serviceName), url.PathEscape(string(taskID)))
 
-	ctx, cancel := context.WithTimeout(context.Background(), retryDuration)
-	defer cancel()
-
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		var task map[string]any
-		if err := httpLib.Client.Get(endpoint, &task); err != nil {
-			return nil, fmt.Errorf("error fetching operation: %w", err)
-		}
-
-		switch task["state"].(string) {
-		case "blocked", "cancelled", "error":
-			return task, fmt.Errorf("task %s ended in error state %q", taskID, task["state"])
-		case "done":
-			return task, nil
-		}
-
-		select {
-		case <-ctx.Done():
-			return nil, errors.New("timeout waiting for task to complete")
-		case <-ticker.C:
-			log.Printf("Still waiting for task to complete (status=%s)…", task["state"])
-			continue
-		}
-	}
+	return wait.ForResourceStatus(
+		ctx,
+		fmt.Sprintf("task %s", taskID),
+		endpoint,
+		"state",
+		[]string{"done"},
+		[]string{"blocked", "cancelled", "error"},
+		wait.Options{Timeout: timeout, PollInterval: 5 * time.Second},
+	)
 }
 
-func getAvailableImages(serviceName string) (map[string]string, error) {
+func getAvailableImages(ctx context.Context, serviceName string) (map[string]string, error) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/images/available", url.PathEscape(serviceName))
 
-	images, err := httpLib.FetchExpandedArray(endpoint, "")
+	images, err := httpLib.FetchExpandedArray(ctx, endpoint, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch available images: %w", err)
 	}
@@ -80,8 +62,8 @@ func getAvailableImages(serviceName string) (map[string]string, error) {
 	return imageChoices, nil
 }
 
-func runImageSelector(serviceName string) (string, string, error) {
-	imageChoices, err := getAvailableImages(serviceName)
+func runImageSelector(ctx context.Context, serviceName string) (string, string, error) {
+	imageChoices, err := getAvailableImages(ctx, serviceName)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to get available images: %w", err)
 	}
@@ -94,10 +76,10 @@ func runImageSelector(serviceName string) (string, string, error) {
 	return selectedImage, selectedID, nil
 }
 
-func runSSHKeySelector() (string, string, error) {
+func runSSHKeySelector(ctx context.Context) (string, string, error) {
 	endpoint := "/v1/me/sshKey"
 
-	keys, err := httpLib.FetchExpandedArray(endpoint, "")
+	keys, err := httpLib.FetchExpandedArray(ctx, endpoint, "")
 	if err != nil {
 		return "", "", fmt.Errorf("failed to fetch account ssh keys: %w", err)
 	}
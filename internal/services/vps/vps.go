@@ -18,6 +18,7 @@ import (
 	"github.com/ovh/ovhcloud-cli/internal/flags"
 	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
 	"github.com/ovh/ovhcloud-cli/internal/services/common"
+	"github.com/ovh/ovhcloud-cli/internal/wait"
 	"github.com/spf13/cobra"
 )
 
@@ -81,8 +82,15 @@ var (
 	}
 )
 
-func ListVps(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/vps", "", vpsColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("vps", map[string]*string{
+		"vps":          &vpsTemplate,
+		"vps-snapshot": &vpsSnapshotTemplate,
+	})
+}
+
+func ListVps(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/vps", "", vpsColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetVps(_ *cobra.Command, args []string) {
@@ -105,6 +113,24 @@ func GetVps(_ *cobra.Command, args []string) {
 	display.OutputObject(object, args[0], vpsTemplate, &flags.OutputFormatConfig)
 }
 
+func WaitVps(cmd *cobra.Command, args []string) {
+	result, err := wait.ForCondition(
+		cmd.Context(),
+		fmt.Sprintf("VPS %s", args[0]),
+		fmt.Sprintf("/v1/vps/%s", url.PathEscape(args[0])),
+		"state",
+		flags.WaitCondition,
+		flags.WaitFailureCondition,
+		wait.Options{Timeout: 30 * time.Minute, PollInterval: 5 * time.Second},
+	)
+	if err != nil {
+		display.OutputError(&flags.OutputFormatConfig, "failed to wait for VPS: %s", err)
+		return
+	}
+
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ VPS %s matches condition %s", args[0], flags.WaitCondition)
+}
+
 func EditVps(cmd *cobra.Command, args []string) {
 	if err := common.EditResource(
 		cmd,
@@ -219,9 +245,9 @@ func GetVpsAutomatedBackup(_ *cobra.Command, args []string) {
 	display.OutputObject(object, args[0], "", &flags.OutputFormatConfig)
 }
 
-func ListVpsAutomatedBackups(_ *cobra.Command, args []string) {
+func ListVpsAutomatedBackups(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/automatedBackup/attachedBackup", url.PathEscape(args[0]))
-	common.ManageListRequestNoExpand(endpoint, []string{"restorePoint"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"restorePoint"}, flags.GenericFilters)
 }
 
 func DetachVpsAutomatedBackup(_ *cobra.Command, args []string) {
@@ -281,9 +307,9 @@ func ListVpsAutomatedBackupRestorePoints(_ *cobra.Command, args []string) {
 	display.RenderTable(pointsMaps, []string{"restorePoint"}, &flags.OutputFormatConfig)
 }
 
-func ListVpsAvailableUpgrades(_ *cobra.Command, args []string) {
+func ListVpsAvailableUpgrades(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/availableUpgrade", url.PathEscape(args[0]))
-	common.ManageListRequestNoExpand(endpoint, []string{"name", "offer", "vcore", "memory", "disk"}, flags.GenericFilters)
+	common.ManageListRequestNoExpand(cmd.Context(), endpoint, []string{"name", "offer", "vcore", "memory", "disk"}, flags.GenericFilters)
 }
 
 func ChangeVpsContacts(_ *cobra.Command, args []string) {
@@ -349,9 +375,9 @@ func ConfirmVpsTermination(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ VPS %s termination confirmed", args[0])
 }
 
-func ListVpsDisks(_ *cobra.Command, args []string) {
+func ListVpsDisks(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/disks", url.PathEscape(args[0]))
-	common.ManageListRequest(endpoint, "", []string{"id", "serviceName", "size", "type", "state"}, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), endpoint, "", []string{"id", "serviceName", "size", "type", "state"}, flags.GenericFilters)
 }
 
 func GetVpsDisk(_ *cobra.Command, args []string) {
@@ -383,11 +409,11 @@ func VpsGetConsoleURL(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Console URL for VPS %s: %s", args[0], consoleURL)
 }
 
-func GetVpsImages(_ *cobra.Command, args []string) {
+func GetVpsImages(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/images/available", url.PathEscape(args[0]))
 
 	// Fetch available images
-	body, err := httpLib.FetchExpandedArray(endpoint, "")
+	body, err := httpLib.FetchExpandedArray(cmd.Context(), endpoint, "")
 	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "failed to fetch results: %s", err)
 		return
@@ -417,9 +443,9 @@ func GetVpsImages(_ *cobra.Command, args []string) {
 	display.RenderTable(body, []string{"id", "name", "current"}, &flags.OutputFormatConfig)
 }
 
-func ListVpsIPs(_ *cobra.Command, args []string) {
+func ListVpsIPs(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/ips", url.PathEscape(args[0]))
-	common.ManageListRequest(endpoint, "", []string{"ipAddress", "reverse", "type", "geolocation", "gateway", "macAddress"}, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), endpoint, "", []string{"ipAddress", "reverse", "type", "geolocation", "gateway", "macAddress"}, flags.GenericFilters)
 }
 
 func SetVpsIPReverse(_ *cobra.Command, args []string) {
@@ -448,12 +474,12 @@ func ReleaseVpsIP(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ IP %s released from VPS %s", args[1], args[0])
 }
 
-func ListVPSOptions(_ *cobra.Command, args []string) {
+func ListVPSOptions(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/option", url.PathEscape(args[0]))
-	common.ManageListRequest(endpoint, "", []string{"option", "state"}, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), endpoint, "", []string{"option", "state"}, flags.GenericFilters)
 }
 
-func StartVps(_ *cobra.Command, args []string) {
+func StartVps(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/start", url.PathEscape(args[0]))
 
 	var response map[string]any
@@ -470,15 +496,16 @@ func StartVps(_ *cobra.Command, args []string) {
 	}
 
 	// Wait for the task to complete
-	if _, err := waitForVpsTask(args[0], response, 10*time.Minute); err != nil {
+	result, err := waitForVpsTask(cmd.Context(), args[0], response, 10*time.Minute)
+	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "error waiting for start task to complete: %s", err)
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ VPS %s started successfully", args[0])
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ VPS %s started successfully", args[0])
 }
 
-func StopVps(_ *cobra.Command, args []string) {
+func StopVps(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/stop", url.PathEscape(args[0]))
 
 	var response map[string]any
@@ -495,15 +522,16 @@ func StopVps(_ *cobra.Command, args []string) {
 	}
 
 	// Wait for the task to complete
-	if _, err := waitForVpsTask(args[0], response, 10*time.Minute); err != nil {
+	result, err := waitForVpsTask(cmd.Context(), args[0], response, 10*time.Minute)
+	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "error waiting for stop task to complete: %s", err)
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ VPS %s stopped successfully", args[0])
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ VPS %s stopped successfully", args[0])
 }
 
-func RebootVps(_ *cobra.Command, args []string) {
+func RebootVps(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/reboot", url.PathEscape(args[0]))
 
 	var response map[string]any
@@ -520,19 +548,20 @@ func RebootVps(_ *cobra.Command, args []string) {
 	}
 
 	// Wait for the task to complete
-	if _, err := waitForVpsTask(args[0], response, 10*time.Minute); err != nil {
+	result, err := waitForVpsTask(cmd.Context(), args[0], response, 10*time.Minute)
+	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "error waiting for reboot task to complete: %s", err)
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ VPS %s reboot completed successfully", args[0])
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ VPS %s reboot completed successfully", args[0])
 }
 
 func ReinstallVps(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/rebuild", url.PathEscape(args[0]))
 
 	if VpsImageViaInteractiveSelector {
-		_, id, err := runImageSelector(args[0])
+		_, id, err := runImageSelector(cmd.Context(), args[0])
 		if err != nil {
 			display.OutputError(&flags.OutputFormatConfig, "error selecting image: %s", err)
 			return
@@ -541,7 +570,7 @@ func ReinstallVps(cmd *cobra.Command, args []string) {
 	}
 
 	if VpsSSHKeyViaInteractiveSelector {
-		keyName, _, err := runSSHKeySelector()
+		keyName, _, err := runSSHKeySelector(cmd.Context())
 		if err != nil {
 			display.OutputError(&flags.OutputFormatConfig, "error selecting SSH key: %s", err)
 			return
@@ -571,17 +600,18 @@ func ReinstallVps(cmd *cobra.Command, args []string) {
 	}
 
 	// Wait for the task to complete
-	if _, err := waitForVpsTask(args[0], response, 20*time.Minute); err != nil {
+	result, err := waitForVpsTask(cmd.Context(), args[0], response, 20*time.Minute)
+	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "error waiting for reinstall task to complete: %s", err)
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ VPS %s reinstalled successfully", args[0])
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ VPS %s reinstalled successfully", args[0])
 }
 
-func ListVpsSecondaryDNSDomains(_ *cobra.Command, args []string) {
+func ListVpsSecondaryDNSDomains(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/secondaryDnsDomains", url.PathEscape(args[0]))
-	common.ManageListRequest(endpoint, "", []string{"domain", "dns", "ipMaster", "creationDate"}, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), endpoint, "", []string{"domain", "dns", "ipMaster", "creationDate"}, flags.GenericFilters)
 }
 
 func AddVpsSecondaryDNSDomain(cmd *cobra.Command, args []string) {
@@ -614,7 +644,7 @@ func DeleteVpsSecondaryDNSDomain(_ *cobra.Command, args []string) {
 	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ Secondary DNS domain %s deleted from VPS %s", args[1], args[0])
 }
 
-func ChangeVpsPassword(_ *cobra.Command, args []string) {
+func ChangeVpsPassword(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/setPassword", url.PathEscape(args[0]))
 
 	var response map[string]any
@@ -631,15 +661,16 @@ func ChangeVpsPassword(_ *cobra.Command, args []string) {
 	}
 
 	// Wait for the task to complete
-	if _, err := waitForVpsTask(args[0], response, 20*time.Minute); err != nil {
+	result, err := waitForVpsTask(cmd.Context(), args[0], response, 20*time.Minute)
+	if err != nil {
 		display.OutputError(&flags.OutputFormatConfig, "error waiting for task to complete: %s", err)
 		return
 	}
 
-	display.OutputInfo(&flags.OutputFormatConfig, nil, "✅ VPS %s process to set the root password completed successfully", args[0])
+	display.OutputInfo(&flags.OutputFormatConfig, result, "✅ VPS %s process to set the root password completed successfully", args[0])
 }
 
-func ListVpsTasks(_ *cobra.Command, args []string) {
+func ListVpsTasks(cmd *cobra.Command, args []string) {
 	endpoint := fmt.Sprintf("/v1/vps/%s/tasks", url.PathEscape(args[0]))
-	common.ManageListRequest(endpoint, "", []string{"id", "type", "state", "date", "progress"}, flags.GenericFilters)
+	common.ManageListRequest(cmd.Context(), endpoint, "", []string{"id", "type", "state", "date", "progress"}, flags.GenericFilters)
 }
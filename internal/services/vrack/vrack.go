@@ -28,8 +28,14 @@ var (
 	}
 )
 
-func ListVrack(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/vrack", "", vrackColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("vrack", map[string]*string{
+		"vrack": &vrackTemplate,
+	})
+}
+
+func ListVrack(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/vrack", "", vrackColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetVrack(_ *cobra.Command, args []string) {
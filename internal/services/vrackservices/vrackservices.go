@@ -23,8 +23,14 @@ var (
 	vrackservicesTemplate string
 )
 
-func ListVrackServices(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v2/vrackServices/resource", "id", vrackservicesColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("vrackservices", map[string]*string{
+		"vrackservices": &vrackservicesTemplate,
+	})
+}
+
+func ListVrackServices(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v2/vrackServices/resource", "id", vrackservicesColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetVrackServices(_ *cobra.Command, args []string) {
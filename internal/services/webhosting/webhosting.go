@@ -27,8 +27,14 @@ var (
 	}
 )
 
-func ListWebHosting(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/hosting/web", "", webhostingColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("webhosting", map[string]*string{
+		"webhosting": &webhostingTemplate,
+	})
+}
+
+func ListWebHosting(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/hosting/web", "", webhostingColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetWebHosting(_ *cobra.Command, args []string) {
@@ -29,8 +29,14 @@ var (
 	}
 )
 
-func ListXdsl(_ *cobra.Command, _ []string) {
-	common.ManageListRequest("/v1/xdsl", "", xdslColumnsToDisplay, flags.GenericFilters)
+func init() {
+	display.RegisterTemplates("xdsl", map[string]*string{
+		"xdsl": &xdslTemplate,
+	})
+}
+
+func ListXdsl(cmd *cobra.Command, _ []string) {
+	common.ManageListRequest(cmd.Context(), "/v1/xdsl", "", xdslColumnsToDisplay, flags.GenericFilters)
 }
 
 func GetXdsl(_ *cobra.Command, args []string) {
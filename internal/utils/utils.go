@@ -10,6 +10,7 @@ import (
 	"runtime"
 
 	"dario.cat/mergo"
+	"github.com/charmbracelet/x/term"
 )
 
 func MergeMaps(left, right map[string]any) error {
@@ -20,11 +21,29 @@ func MergeMaps(left, right map[string]any) error {
 	return nil
 }
 
+// StdinReserved is set when the standard input is used to read commands (e.g. by the
+// interactive shell), so that it is not read as the parameters of the commands
+var StdinReserved bool
+
 func IsInputFromPipe() bool {
 	if runtime.GOARCH == "wasm" && runtime.GOOS == "js" {
 		return false
 	}
 
+	if StdinReserved {
+		return false
+	}
+
 	fileInfo, _ := os.Stdin.Stat()
 	return fileInfo.Mode()&os.ModeCharDevice == 0
 }
+
+// IsInteractiveTerminal returns whether both the standard input and output
+// are attached to a terminal, so that the user can be prompted
+func IsInteractiveTerminal() bool {
+	if runtime.GOARCH == "wasm" && runtime.GOOS == "js" {
+		return false
+	}
+
+	return term.IsTerminal(os.Stdin.Fd()) && term.IsTerminal(os.Stdout.Fd())
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wait
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/x/term"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// progress displays the state of a wait. When stderr is a terminal, a live spinner
+// line is displayed, otherwise a log line is written at each status update.
+type progress struct {
+	description string
+	start       time.Time
+	interactive bool
+
+	mutex  sync.Mutex
+	status string
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+func startProgress(description string) *progress {
+	p := &progress{
+		description: description,
+		start:       time.Now(),
+		// Don't mix the spinner with debug logs
+		interactive: term.IsTerminal(os.Stderr.Fd()) && !flags.Debug,
+		done:        make(chan struct{}),
+	}
+
+	if p.interactive {
+		p.wg.Add(1)
+		go p.spin()
+	}
+
+	return p
+}
+
+func (p *progress) spin() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for frame := 0; ; frame++ {
+		p.mutex.Lock()
+		status := p.status
+		p.mutex.Unlock()
+
+		line := fmt.Sprintf("%s Waiting for %s", spinnerFrames[frame%len(spinnerFrames)], p.description)
+		if status != "" {
+			line += fmt.Sprintf(" (status=%s)", status)
+		}
+		fmt.Fprintf(os.Stderr, "\r\033[K%s… %s", line, time.Since(p.start).Round(time.Second))
+
+		select {
+		case <-p.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *progress) update(status string) {
+	if !p.interactive {
+		log.Printf("Still waiting for %s (status=%s)…", p.description, status)
+		return
+	}
+
+	p.mutex.Lock()
+	p.status = status
+	p.mutex.Unlock()
+}
+
+func (p *progress) stop() {
+	if !p.interactive {
+		return
+	}
+
+	close(p.done)
+	p.wg.Wait()
+
+	// Clear the spinner line
+	fmt.Fprint(os.Stderr, "\r\033[K")
+}
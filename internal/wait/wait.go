@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/PaesslerAG/gval"
+	"github.com/ovh/ovhcloud-cli/internal/filters"
+	"github.com/ovh/ovhcloud-cli/internal/flags"
+	httpLib "github.com/ovh/ovhcloud-cli/internal/http"
+)
+
+const (
+	// backoffFactor is applied to the poll interval after each poll
+	backoffFactor = 1.5
+
+	// maxIntervalFactor limits the poll interval to the given multiple of the initial one
+	maxIntervalFactor = 4
+)
+
+// Options define the default timeout and poll interval of a wait. They
+// can be overridden using --wait-timeout and --poll-interval flags.
+type Options struct {
+	Timeout      time.Duration
+	PollInterval time.Duration
+
+	// InitialDelay is the time to wait before the first poll, for the
+	// resources whose status is not updated synchronously by the API
+	InitialDelay time.Duration
+}
+
+// Status is the state of the awaited resource or task, as returned by a PollFunc
+type Status struct {
+	// Value is the status returned by the API
+	Value string
+
+	// Done indicates that the resource or task reached the awaited state
+	Done bool
+
+	// Resource is the last known state of the resource or task
+	Resource map[string]any
+}
+
+// PollFunc fetches the current status of the awaited resource or task.
+// A returned error stops the wait.
+type PollFunc func(ctx context.Context) (Status, error)
+
+// Result is the final status of a wait
+type Result struct {
+	Description string         `json:"description"`
+	Status      string         `json:"status"`
+	Attempts    int            `json:"attempts"`
+	Elapsed     string         `json:"elapsed"`
+	Resource    map[string]any `json:"resource,omitempty"`
+}
+
+// For polls the given function until it reports that the wait is over. The wait ends in
+// error when the timeout is reached or when the given context is cancelled, e.g. when
+// the user interrupts the command (Ctrl-C).
+func For(ctx context.Context, description string, options Options, poll PollFunc) (*Result, error) {
+	timeout := options.Timeout
+	if flags.WaitTimeout > 0 {
+		timeout = flags.WaitTimeout
+	}
+
+	initialInterval := options.PollInterval
+	if flags.PollInterval > 0 {
+		initialInterval = flags.PollInterval
+	}
+	interval := initialInterval
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		start    = time.Now()
+		result   = &Result{Description: description}
+		progress = startProgress(description)
+	)
+	defer progress.stop()
+
+	select {
+	case <-ctx.Done():
+		return result, waitError(ctx, timeout, result)
+	case <-time.After(options.InitialDelay):
+	}
+
+	for {
+		status, err := poll(ctx)
+		result.Attempts++
+		result.Elapsed = time.Since(start).Round(time.Second).String()
+		if err == nil {
+			result.Status = status.Value
+			result.Resource = status.Resource
+		}
+
+		switch {
+		case ctx.Err() != nil:
+			return result, waitError(ctx, timeout, result)
+		case err != nil:
+			return result, err
+		case status.Done:
+			return result, nil
+		}
+
+		progress.update(status.Value)
+
+		select {
+		case <-ctx.Done():
+			return result, waitError(ctx, timeout, result)
+		case <-time.After(interval):
+		}
+
+		interval = nextPollInterval(interval, initialInterval)
+	}
+}
+
+// nextPollInterval returns the interval following the given one, increased by
+// backoffFactor up to maxIntervalFactor times the initial interval
+func nextPollInterval(interval, initialInterval time.Duration) time.Duration {
+	return min(time.Duration(float64(interval)*backoffFactor), initialInterval*maxIntervalFactor)
+}
+
+// waitError returns the error explaining why the given context ended
+func waitError(ctx context.Context, timeout time.Duration, result *Result) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timeout after %s waiting for %s (status=%s)", timeout, result.Description, result.Status)
+	}
+
+	return fmt.Errorf("interrupted while waiting for %s (status=%s)", result.Description, result.Status)
+}
+
+// ForResourceStatus waits for the field statusField of the object returned by the given
+// endpoint to have one of the target values. Reaching one of the failure values ends the
+// wait in error.
+func ForResourceStatus(ctx context.Context, description, endpoint, statusField string, targets, failures []string, options Options) (*Result, error) {
+	return For(ctx, description, options, func(ctx context.Context) (Status, error) {
+		var resource map[string]any
+		if err := httpLib.Client.GetWithContext(ctx, endpoint, &resource); err != nil {
+			return Status{}, fmt.Errorf("failed to fetch %s: %w", endpoint, err)
+		}
+
+		value := fmt.Sprint(resource[statusField])
+		if slices.Contains(failures, value) {
+			return Status{}, fmt.Errorf("stopped waiting for %s: status is %q", description, value)
+		}
+
+		return Status{
+			Value:    value,
+			Done:     slices.Contains(targets, value),
+			Resource: resource,
+		}, nil
+	})
+}
+
+// ForCondition waits for the object returned by the given endpoint to match the given
+// gval condition. Matching the failure condition, if any, ends the wait in error. The
+// value of statusField is only used to report the progress of the wait.
+func ForCondition(ctx context.Context, description, endpoint, statusField, condition, failure string, options Options) (*Result, error) {
+	language := gval.Full(filters.AdditionalEvaluators...)
+
+	conditionEv, err := language.NewEvaluable(condition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse condition %q: %w", condition, err)
+	}
+
+	var failureEv gval.Evaluable
+	if failure != "" {
+		failureEv, err = language.NewEvaluable(failure)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse failure condition %q: %w", failure, err)
+		}
+	}
+
+	return For(ctx, description, options, func(ctx context.Context) (Status, error) {
+		var resource map[string]any
+		if err := httpLib.Client.GetWithContext(ctx, endpoint, &resource); err != nil {
+			return Status{}, fmt.Errorf("failed to fetch %s: %w", endpoint, err)
+		}
+
+		if failureEv != nil {
+			failed, err := failureEv.EvalBool(ctx, resource)
+			if err != nil {
+				return Status{}, fmt.Errorf("failed to evaluate failure condition: %w", err)
+			}
+			if failed {
+				return Status{}, fmt.Errorf("stopped waiting for %s: failure condition %q matched", description, failure)
+			}
+		}
+
+		done, err := conditionEv.EvalBool(ctx, resource)
+		if err != nil {
+			return Status{}, fmt.Errorf("failed to evaluate condition: %w", err)
+		}
+
+		return Status{
+			Value:    fmt.Sprint(resource[statusField]),
+			Done:     done,
+			Resource: resource,
+		}, nil
+	})
+}
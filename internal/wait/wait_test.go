@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 OVH SAS <opensource@ovh.net>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wait
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maxatome/go-testdeep/td"
+)
+
+// pollTimes returns a PollFunc reporting the given statuses in sequence, the
+// last one being repeated, and a pointer to the times of the polls
+func pollTimes(statuses ...Status) (PollFunc, *[]time.Time) {
+	var times []time.Time
+	return func(context.Context) (Status, error) {
+		times = append(times, time.Now())
+		return statuses[min(len(times), len(statuses))-1], nil
+	}, &times
+}
+
+func TestForDone(t *testing.T) {
+	poll, times := pollTimes(
+		Status{Value: "BUILDING"},
+		Status{Value: "BUILDING"},
+		Status{Value: "ACTIVE", Done: true, Resource: map[string]any{"id": "instance-1"}},
+	)
+
+	result, err := For(context.Background(), "instance instance-1", Options{Timeout: time.Second, PollInterval: time.Millisecond}, poll)
+	td.CmpNoError(t, err)
+	td.Cmp(t, result, &Result{
+		Description: "instance instance-1",
+		Status:      "ACTIVE",
+		Attempts:    3,
+		Elapsed:     "0s",
+		Resource:    map[string]any{"id": "instance-1"},
+	})
+	td.Cmp(t, *times, td.Len(3))
+}
+
+func TestForTimeout(t *testing.T) {
+	poll, _ := pollTimes(Status{Value: "BUILDING"})
+
+	start := time.Now()
+	result, err := For(context.Background(), "instance instance-1", Options{Timeout: 50 * time.Millisecond, PollInterval: 10 * time.Millisecond}, poll)
+	td.CmpString(t, err, "timeout after 50ms waiting for instance instance-1 (status=BUILDING)")
+	td.Cmp(t, result.Attempts, td.Gt(1))
+	td.Cmp(t, time.Since(start), td.Between(50*time.Millisecond, time.Second))
+}
+
+func TestNextPollInterval(t *testing.T) {
+	var intervals []time.Duration
+	for interval := 10 * time.Second; len(intervals) < 6; {
+		interval = nextPollInterval(interval, 10*time.Second)
+		intervals = append(intervals, interval)
+	}
+
+	// The interval grows by 1.5 until 4 times the initial interval
+	td.Cmp(t, intervals, []time.Duration{
+		15 * time.Second,
+		22500 * time.Millisecond,
+		33750 * time.Millisecond,
+		40 * time.Second,
+		40 * time.Second,
+		40 * time.Second,
+	})
+}
+
+func TestForBackoff(t *testing.T) {
+	poll, times := pollTimes(
+		Status{Value: "BUILDING"},
+		Status{Value: "BUILDING"},
+		Status{Value: "BUILDING"},
+		Status{Value: "ACTIVE", Done: true},
+	)
+
+	_, err := For(context.Background(), "instance instance-1", Options{Timeout: 5 * time.Second, PollInterval: 20 * time.Millisecond}, poll)
+	td.CmpNoError(t, err)
+	td.Require(t).Cmp(*times, td.Len(4))
+
+	// The delays between the polls increase
+	for i, interval := range []time.Duration{20 * time.Millisecond, 30 * time.Millisecond, 45 * time.Millisecond} {
+		td.Cmp(t, (*times)[i+1].Sub((*times)[i]), td.Gte(interval), "delay before poll #%d", i+2)
+	}
+}
+
+func TestForInitialDelay(t *testing.T) {
+	poll, times := pollTimes(Status{Value: "ACTIVE", Done: true})
+
+	start := time.Now()
+	_, err := For(context.Background(), "instance instance-1", Options{Timeout: time.Second, PollInterval: time.Millisecond, InitialDelay: 50 * time.Millisecond}, poll)
+	td.CmpNoError(t, err)
+	td.Require(t).Cmp(*times, td.Len(1))
+	td.Cmp(t, (*times)[0].Sub(start), td.Gte(50*time.Millisecond))
+}
+
+func TestForCancelledDuringInitialDelay(t *testing.T) {
+	poll, times := pollTimes(Status{Value: "ACTIVE", Done: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	result, err := For(ctx, "instance instance-1", Options{Timeout: time.Minute, PollInterval: time.Millisecond, InitialDelay: time.Minute}, poll)
+	td.CmpString(t, err, "interrupted while waiting for instance instance-1 (status=)")
+	td.Cmp(t, result.Attempts, 0)
+	td.Cmp(t, *times, td.Len(0))
+}
+
+func TestForCancelledDuringPoll(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The poll is stopped by the cancellation, its error is not reported
+	result, err := For(ctx, "instance instance-1", Options{Timeout: time.Minute, PollInterval: time.Millisecond}, func(ctx context.Context) (Status, error) {
+		cancel()
+		<-ctx.Done()
+		return Status{}, ctx.Err()
+	})
+	td.CmpString(t, err, "interrupted while waiting for instance instance-1 (status=)")
+	td.Cmp(t, result.Attempts, 1)
+}
+
+func TestForCancelledDuringInterval(t *testing.T) {
+	poll, times := pollTimes(Status{Value: "BUILDING"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	result, err := For(ctx, "instance instance-1", Options{Timeout: time.Minute, PollInterval: time.Minute}, poll)
+	td.CmpString(t, err, "interrupted while waiting for instance instance-1 (status=BUILDING)")
+	td.Cmp(t, result.Attempts, 1)
+	td.Cmp(t, *times, td.Len(1))
+}
+
+func TestForPollError(t *testing.T) {
+	pollErr := errors.New("failed to fetch instance")
+
+	attempts := 0
+	result, err := For(context.Background(), "instance instance-1", Options{Timeout: time.Second, PollInterval: time.Millisecond}, func(context.Context) (Status, error) {
+		attempts++
+		if attempts == 2 {
+			return Status{}, pollErr
+		}
+		return Status{Value: "BUILDING"}, nil
+	})
+	td.Cmp(t, err, td.ErrorIs(pollErr))
+	td.Cmp(t, result.Attempts, 2)
+
+	// The status of the last successful poll is kept
+	td.Cmp(t, result.Status, "BUILDING")
+}